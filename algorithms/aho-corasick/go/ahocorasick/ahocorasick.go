@@ -0,0 +1,141 @@
+package ahocorasick
+
+// Match is an occurrence of Patterns[Pattern] starting at Start
+type Match struct {
+	Pattern int
+	Start   int
+}
+
+type state struct {
+	next   map[byte]int
+	fail   int   // longest proper suffix of this state which is also a state
+	output int   // closest state on the fail chain which ends a pattern, or -1
+	ends   []int // patterns ending exactly at this state
+}
+
+// Matcher finds every occurrence of a set of patterns in a single pass over
+// the text, no matter how many patterns there are
+type Matcher struct {
+	patterns []string
+	states   []state
+}
+
+// New builds the automaton for patterns - this is O(total length of patterns).
+// Empty patterns are ignored and never reported as matches
+func New(patterns []string) *Matcher {
+	m := &Matcher{
+		patterns: patterns,
+		states:   []state{{next: map[byte]int{}, output: -1}},
+	}
+
+	// build the trie of all patterns
+	for i, p := range patterns {
+		if p == "" {
+			continue
+		}
+
+		s := 0
+		for j := 0; j < len(p); j++ {
+			next, ok := m.states[s].next[p[j]]
+			if !ok {
+				next = len(m.states)
+				m.states = append(m.states, state{next: map[byte]int{}, output: -1})
+				m.states[s].next[p[j]] = next
+			}
+			s = next
+		}
+
+		m.states[s].ends = append(m.states[s].ends, i)
+	}
+
+	// fill in the fail links breadth first, so a state's fail link is always
+	// computed after the ones of all shallower states
+	queue := []int{}
+	for _, child := range m.states[0].next {
+		queue = append(queue, child)
+	}
+
+	for len(queue) > 0 {
+		s := queue[0]
+		queue = queue[1:]
+
+		for b, child := range m.states[s].next {
+			f := m.states[s].fail
+			for f != 0 && !m.has(f, b) {
+				f = m.states[f].fail
+			}
+			if next, ok := m.states[f].next[b]; ok {
+				f = next
+			} else {
+				f = 0
+			}
+
+			m.states[child].fail = f
+			if len(m.states[f].ends) > 0 {
+				m.states[child].output = f
+			} else {
+				m.states[child].output = m.states[f].output
+			}
+
+			queue = append(queue, child)
+		}
+	}
+
+	return m
+}
+
+func (m *Matcher) has(s int, b byte) bool {
+	_, ok := m.states[s].next[b]
+	return ok
+}
+
+// step follows the automaton from s on b, falling back along fail links
+func (m *Matcher) step(s int, b byte) int {
+	for {
+		if next, ok := m.states[s].next[b]; ok {
+			return next
+		}
+		if s == 0 {
+			return 0
+		}
+		s = m.states[s].fail
+	}
+}
+
+// FindAll returns every match in text ordered by where it ends - this is
+// O(len(text) + matches)
+func (m *Matcher) FindAll(text []byte) []Match {
+	var matches []Match
+	m.scan(text, func(match Match) bool {
+		matches = append(matches, match)
+		return true
+	})
+
+	return matches
+}
+
+// Contains checks if any of the patterns occurs in text
+func (m *Matcher) Contains(text []byte) bool {
+	found := false
+	m.scan(text, func(Match) bool {
+		found = true
+		return false
+	})
+
+	return found
+}
+
+func (m *Matcher) scan(text []byte, fn func(Match) bool) {
+	s := 0
+	for i, b := range text {
+		s = m.step(s, b)
+
+		for o := s; o != -1; o = m.states[o].output {
+			for _, p := range m.states[o].ends {
+				if !fn(Match{Pattern: p, Start: i + 1 - len(m.patterns[p])}) {
+					return
+				}
+			}
+		}
+	}
+}
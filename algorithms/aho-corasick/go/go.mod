@@ -0,0 +1,3 @@
+module github.com/fr3fou/random-stuff/algorithms/aho-corasick/go
+
+go 1.21
@@ -0,0 +1,128 @@
+// Package delta computes binary deltas made of copies from the old version
+// and inserted literal bytes
+package delta
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// BlockSize is the length of the blocks of the old version which get indexed
+const BlockSize = 16
+
+const (
+	opCopy   = 'C'
+	opInsert = 'I'
+)
+
+// ErrCorrupt is returned when applying a delta which wasn't produced by Diff
+// or was made against different old data
+var ErrCorrupt = errors.New("delta: corrupt delta")
+
+// Diff returns the delta turning old into next
+func Diff(old, next []byte) []byte {
+	index := map[string]int{}
+	for i := 0; i+BlockSize <= len(old); i += BlockSize {
+		key := string(old[i : i+BlockSize])
+		if _, ok := index[key]; !ok {
+			index[key] = i
+		}
+	}
+
+	out := binary.AppendUvarint(nil, uint64(len(next)))
+
+	literal := 0 // start of the bytes not covered by a copy yet
+	for i := 0; i < len(next); {
+		j, ok := -1, false
+		if i+BlockSize <= len(next) {
+			j, ok = index[string(next[i:i+BlockSize])]
+		}
+
+		if !ok {
+			i++
+			continue
+		}
+
+		// grow the match backwards into the pending literal bytes
+		start, from := i, j
+		for start > literal && from > 0 && next[start-1] == old[from-1] {
+			start--
+			from--
+		}
+
+		end := i + BlockSize
+		for end < len(next) && from+(end-start) < len(old) && next[end] == old[from+(end-start)] {
+			end++
+		}
+
+		out = insert(out, next[literal:start])
+		out = append(out, opCopy)
+		out = binary.AppendUvarint(out, uint64(from))
+		out = binary.AppendUvarint(out, uint64(end-start))
+
+		i, literal = end, end
+	}
+
+	return insert(out, next[literal:])
+}
+
+func insert(out, data []byte) []byte {
+	if len(data) == 0 {
+		return out
+	}
+
+	out = append(out, opInsert)
+	out = binary.AppendUvarint(out, uint64(len(data)))
+	return append(out, data...)
+}
+
+// Patch applies delta to old, returning the new version
+func Patch(old, delta []byte) ([]byte, error) {
+	size, n := binary.Uvarint(delta)
+	if n <= 0 {
+		return nil, ErrCorrupt
+	}
+	delta = delta[n:]
+
+	// the header can't be trusted, so don't preallocate more than the delta can
+	// produce - every op below also makes sure out never grows past size
+	out := make([]byte, 0, min(size, uint64(len(old))+uint64(len(delta))))
+	for len(delta) > 0 {
+		op := delta[0]
+		delta = delta[1:]
+
+		switch op {
+		case opCopy:
+			from, n := binary.Uvarint(delta)
+			if n <= 0 {
+				return nil, ErrCorrupt
+			}
+			delta = delta[n:]
+
+			length, n := binary.Uvarint(delta)
+			if n <= 0 || length > uint64(len(old)) || from > uint64(len(old))-length || length > size-uint64(len(out)) {
+				return nil, ErrCorrupt
+			}
+			delta = delta[n:]
+
+			out = append(out, old[from:from+length]...)
+		case opInsert:
+			length, n := binary.Uvarint(delta)
+			if n <= 0 || length > uint64(len(delta)-n) || length > size-uint64(len(out)) {
+				return nil, ErrCorrupt
+			}
+			delta = delta[n:]
+
+			out = append(out, delta[:length]...)
+			delta = delta[length:]
+		default:
+			return nil, ErrCorrupt
+		}
+	}
+
+	if uint64(len(out)) != size {
+		return nil, ErrCorrupt
+	}
+
+	return out, nil
+}
@@ -0,0 +1,70 @@
+package delta
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/rand"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	random := make([]byte, 4096)
+	r.Read(random)
+
+	edited := append([]byte{}, random[:1000]...)
+	edited = append(edited, "inserted"...)
+	edited = append(edited, random[1200:]...)
+
+	for name, c := range map[string]struct{ old, next []byte }{
+		"empty":        {nil, nil},
+		"from nothing": {nil, random},
+		"to nothing":   {random, nil},
+		"identical":    {random, random},
+		"edited":       {random, edited},
+		"unrelated":    {random[:2048], random[2048:]},
+	} {
+		got, err := Patch(c.old, Diff(c.old, c.next))
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if !bytes.Equal(got, c.next) {
+			t.Errorf("%s: patched data doesn't match", name)
+		}
+	}
+}
+
+func TestPatchCorrupt(t *testing.T) {
+	old := make([]byte, 1<<16)
+
+	header := func(size uint64) []byte {
+		return binary.AppendUvarint(nil, size)
+	}
+	copyOp := func(delta []byte, from, length uint64) []byte {
+		delta = append(delta, opCopy)
+		delta = binary.AppendUvarint(delta, from)
+		return binary.AppendUvarint(delta, length)
+	}
+
+	// many cheap copies of all of old, way past the size in the header
+	bomb := header(10)
+	for i := 0; i < 1000; i++ {
+		bomb = copyOp(bomb, 0, uint64(len(old)))
+	}
+
+	for name, delta := range map[string][]byte{
+		"empty":             {},
+		"unknown op":        append(header(1), 'X'),
+		"overflowing copy":  copyOp(header(2), ^uint64(0), 2),
+		"copy past old":     copyOp(header(10), uint64(len(old))-1, 2),
+		"huge size":         header(1 << 62),
+		"copy past size":    bomb,
+		"insert past size":  append(header(1), opInsert, 3, 'a', 'b', 'c'),
+		"insert past delta": append(header(10), opInsert, 5, 'a'),
+		"short":             copyOp(header(10), 0, 5),
+	} {
+		if _, err := Patch(old, delta); err != ErrCorrupt {
+			t.Errorf("%s: expected ErrCorrupt, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/fr3fou/random-stuff/algorithms/binary-diff/go
+
+go 1.21
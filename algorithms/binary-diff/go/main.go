@@ -0,0 +1,44 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+
+	"github.com/fr3fou/random-stuff/algorithms/binary-diff/go/delta"
+)
+
+func main() {
+	r := rand.New(rand.NewSource(1))
+
+	// a file which gets a small edit in every version
+	versions := [][]byte{make([]byte, 64<<10)}
+	r.Read(versions[0])
+	for i := 0; i < 5; i++ {
+		next := append([]byte{}, versions[i]...)
+		at := r.Intn(len(next))
+		next = append(next[:at], append([]byte("edit!"), next[at:]...)...)
+		versions = append(versions, next)
+	}
+
+	// only the first version is stored in full, the rest as deltas against the previous one
+	stored := len(versions[0])
+	current := versions[0]
+	for i := 1; i < len(versions); i++ {
+		d := delta.Diff(versions[i-1], versions[i])
+		stored += len(d)
+
+		var err error
+		current, err = delta.Patch(current, d)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	full := 0
+	for _, v := range versions {
+		full += len(v)
+	}
+
+	fmt.Println(full, stored, bytes.Equal(current, versions[len(versions)-1]))
+}
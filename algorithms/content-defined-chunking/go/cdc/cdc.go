@@ -0,0 +1,84 @@
+package cdc
+
+// window is how many bytes the rolling hash looks at
+const window = 48
+
+// base is the multiplier of the Rabin-Karp polynomial hash
+const base = 257
+
+// Options controls the size of the produced chunks
+type Options struct {
+	Min int
+	Avg int // rounded down to a power of 2
+	Max int
+}
+
+// DefaultOptions produces chunks of around 8KiB
+var DefaultOptions = Options{Min: 2 << 10, Avg: 8 << 10, Max: 64 << 10}
+
+// Split cuts data into chunks whose boundaries depend only on the bytes
+// around them, so inserting or removing bytes in the middle of a file only
+// changes the chunks around the edit instead of shifting every chunk after it.
+//
+// A Rabin-Karp rolling hash is kept over the last window bytes and a
+// boundary is placed whenever its low bits are all 0, which happens on
+// average every opts.Avg bytes
+func Split(data []byte, opts Options) [][]byte {
+	if opts.Min < window {
+		opts.Min = window
+	}
+	if opts.Max < opts.Min {
+		opts.Max = opts.Min
+	}
+
+	mask := uint64(1)
+	for mask*2 <= uint64(opts.Avg) {
+		mask *= 2
+	}
+	mask--
+
+	// base^window, used to remove the byte falling out of the window
+	var pow uint64 = 1
+	for i := 0; i < window; i++ {
+		pow *= base
+	}
+
+	chunks := [][]byte{}
+	for len(data) > 0 {
+		n := boundary(data, opts, mask, pow)
+		chunks = append(chunks, data[:n:n])
+		data = data[n:]
+	}
+
+	return chunks
+}
+
+// boundary returns the length of the first chunk of data
+func boundary(data []byte, opts Options, mask, pow uint64) int {
+	if len(data) <= opts.Min {
+		return len(data)
+	}
+
+	end := opts.Max
+	if end > len(data) {
+		end = len(data)
+	}
+
+	// prime the hash with the window right before the minimum size, since
+	// there is no point looking for a boundary earlier
+	var h uint64
+	for i := opts.Min - window; i < opts.Min; i++ {
+		h = h*base + uint64(data[i])
+	}
+
+	for i := opts.Min; i < end; i++ {
+		if h&mask == 0 {
+			return i
+		}
+
+		// roll: add the new byte, drop the one leaving the window
+		h = h*base + uint64(data[i]) - pow*uint64(data[i-window])
+	}
+
+	return end
+}
@@ -0,0 +1,3 @@
+module github.com/fr3fou/random-stuff/algorithms/content-defined-chunking/go
+
+go 1.21
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/rand"
+
+	"github.com/fr3fou/random-stuff/algorithms/content-defined-chunking/go/cdc"
+)
+
+func main() {
+	original := make([]byte, 1<<20)
+	rand.New(rand.NewSource(1)).Read(original)
+
+	// insert a few bytes in the middle of the file
+	edited := append([]byte{}, original[:500000]...)
+	edited = append(edited, "hello"...)
+	edited = append(edited, original[500000:]...)
+
+	seen := map[[32]byte]bool{}
+	a := cdc.Split(original, cdc.DefaultOptions)
+	for _, c := range a {
+		seen[sha256.Sum256(c)] = true
+	}
+
+	shared := 0
+	b := cdc.Split(edited, cdc.DefaultOptions)
+	for _, c := range b {
+		if seen[sha256.Sum256(c)] {
+			shared++
+		}
+	}
+
+	fmt.Printf("%d chunks, %d of them shared with the original %d\n", len(b), shared, len(a))
+}
@@ -0,0 +1,3 @@
+module duplicate-finder
+
+go 1.21
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+func main() {
+	root := "."
+	if len(os.Args) > 1 {
+		root = os.Args[1]
+	}
+
+	sets, reclaimable, err := findDuplicates(root)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, set := range sets {
+		fmt.Println(set)
+	}
+	fmt.Printf("%d duplicate sets, %d bytes reclaimable\n", len(sets), reclaimable)
+}
+
+// findDuplicates returns the sets of files under root with identical content
+// and how many bytes would be freed by keeping only one file of every set.
+//
+// Hashing every file is slow, so files are first grouped by size, which is
+// free to get from the directory walk - a file with a unique size can't have
+// a duplicate. Only the files sharing a size with another one are hashed
+func findDuplicates(root string) ([][]string, int64, error) {
+	bySize := map[int64][]string{}
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || !d.Type().IsRegular() {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		bySize[info.Size()] = append(bySize[info.Size()], path)
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	sets := [][]string{}
+	reclaimable := int64(0)
+	for size, paths := range bySize {
+		if len(paths) < 2 {
+			continue
+		}
+
+		byHash := map[[sha256.Size]byte][]string{}
+		for _, path := range paths {
+			sum, err := hashFile(path)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			byHash[sum] = append(byHash[sum], path)
+		}
+
+		for _, set := range byHash {
+			if len(set) < 2 {
+				continue
+			}
+
+			sort.Strings(set)
+			sets = append(sets, set)
+			reclaimable += size * int64(len(set)-1)
+		}
+	}
+
+	sort.Slice(sets, func(i, j int) bool { return sets[i][0] < sets[j][0] })
+
+	return sets, reclaimable, nil
+}
+
+func hashFile(path string) ([sha256.Size]byte, error) {
+	var sum [sha256.Size]byte
+
+	f, err := os.Open(path)
+	if err != nil {
+		return sum, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return sum, err
+	}
+
+	copy(sum[:], h.Sum(nil))
+	return sum, nil
+}
@@ -0,0 +1,3 @@
+module github.com/fr3fou/random-stuff/algorithms/huffman-coding/go
+
+go 1.21
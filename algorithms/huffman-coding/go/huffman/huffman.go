@@ -0,0 +1,219 @@
+// Package huffman implements canonical Huffman coding, storing only the code
+// length of every byte in the header
+package huffman
+
+import (
+	"container/heap"
+	"encoding/binary"
+	"errors"
+	"sort"
+)
+
+// ErrCorrupt is returned when decoding data which wasn't produced by Encode
+var ErrCorrupt = errors.New("huffman: corrupt input")
+
+type node struct {
+	weight      int
+	symbol      int // the smallest symbol in the subtree, used to break ties
+	left, right *node
+}
+
+type queue []*node
+
+func (q queue) Len() int { return len(q) }
+func (q queue) Less(i, j int) bool {
+	if q[i].weight != q[j].weight {
+		return q[i].weight < q[j].weight
+	}
+	return q[i].symbol < q[j].symbol
+}
+func (q queue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+func (q *queue) Push(x any)   { *q = append(*q, x.(*node)) }
+func (q *queue) Pop() any {
+	old := *q
+	n := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return n
+}
+
+// codeLengths builds the Huffman tree by repeatedly merging the two lightest
+// subtrees and returns how deep every byte ended up
+func codeLengths(data []byte) [256]uint8 {
+	var freq [256]int
+	for _, b := range data {
+		freq[b]++
+	}
+
+	q := queue{}
+	for s, f := range freq {
+		if f > 0 {
+			q = append(q, &node{weight: f, symbol: s})
+		}
+	}
+	heap.Init(&q)
+
+	var lengths [256]uint8
+	if len(q) == 1 {
+		// a single distinct byte still needs a 1 bit code
+		lengths[q[0].symbol] = 1
+		return lengths
+	}
+
+	for len(q) > 1 {
+		a := heap.Pop(&q).(*node)
+		b := heap.Pop(&q).(*node)
+		heap.Push(&q, &node{
+			weight: a.weight + b.weight,
+			symbol: min(a.symbol, b.symbol),
+			left:   a,
+			right:  b,
+		})
+	}
+
+	var walk func(n *node, depth uint8)
+	walk = func(n *node, depth uint8) {
+		if n.left == nil {
+			lengths[n.symbol] = depth
+			return
+		}
+		walk(n.left, depth+1)
+		walk(n.right, depth+1)
+	}
+	if len(q) > 0 {
+		walk(q[0], 0)
+	}
+
+	return lengths
+}
+
+// sortedSymbols returns the used symbols ordered by code length and then value
+func sortedSymbols(lengths *[256]uint8) []int {
+	symbols := []int{}
+	for s, l := range lengths {
+		if l > 0 {
+			symbols = append(symbols, s)
+		}
+	}
+
+	sort.Slice(symbols, func(i, j int) bool {
+		a, b := symbols[i], symbols[j]
+		if lengths[a] != lengths[b] {
+			return lengths[a] < lengths[b]
+		}
+		return a < b
+	})
+
+	return symbols
+}
+
+// canonicalCodes assigns consecutive codes to symbols in canonical order,
+// appending a 0 bit every time the code length grows
+func canonicalCodes(lengths *[256]uint8) [256]uint64 {
+	var codes [256]uint64
+
+	code, prev := uint64(0), uint8(0)
+	for i, s := range sortedSymbols(lengths) {
+		if i > 0 {
+			code++
+		}
+		code <<= lengths[s] - prev
+		prev = lengths[s]
+		codes[s] = code
+	}
+
+	return codes
+}
+
+// Encode compresses data. The output starts with the length of data and the
+// code length of all 256 bytes, followed by the codes packed MSB first
+func Encode(data []byte) []byte {
+	lengths := codeLengths(data)
+	codes := canonicalCodes(&lengths)
+
+	out := binary.AppendUvarint(nil, uint64(len(data)))
+	out = append(out, lengths[:]...)
+
+	var (
+		acc  byte
+		used uint8
+	)
+	for _, b := range data {
+		for i := int(lengths[b]) - 1; i >= 0; i-- {
+			acc = acc<<1 | byte(codes[b]>>i&1)
+			used++
+			if used == 8 {
+				out = append(out, acc)
+				acc, used = 0, 0
+			}
+		}
+	}
+
+	if used > 0 {
+		out = append(out, acc<<(8-used))
+	}
+
+	return out
+}
+
+// Decode reverses Encode
+func Decode(data []byte) ([]byte, error) {
+	n, read := binary.Uvarint(data)
+	if read <= 0 || len(data) < read+256 {
+		return nil, ErrCorrupt
+	}
+	data = data[read:]
+
+	var lengths [256]uint8
+	copy(lengths[:], data[:256])
+	data = data[256:]
+
+	// for every length, the first canonical code of that length, how many
+	// codes have it and where its symbols start in the canonical order
+	symbols := sortedSymbols(&lengths)
+	var first, count, offset [65]uint64
+
+	code := uint64(0)
+	for i, s := range symbols {
+		l := lengths[s]
+		if l > 64 {
+			return nil, ErrCorrupt
+		}
+
+		if i > 0 {
+			code++
+			code <<= l - lengths[symbols[i-1]]
+		} else {
+			code <<= l
+		}
+
+		if count[l] == 0 {
+			first[l], offset[l] = code, uint64(i)
+		}
+		count[l]++
+	}
+
+	// every byte takes at least 1 bit, which bounds the untrusted header
+	out := make([]byte, 0, min(n, uint64(len(data))*8))
+	code, l := 0, 0
+	for _, b := range data {
+		for bit := 7; bit >= 0 && uint64(len(out)) < n; bit-- {
+			code = code<<1 | uint64(b>>bit&1)
+			l++
+
+			if l > 64 {
+				return nil, ErrCorrupt
+			}
+
+			if count[l] > 0 && code >= first[l] && code-first[l] < count[l] {
+				out = append(out, byte(symbols[offset[l]+code-first[l]]))
+				code, l = 0, 0
+			}
+		}
+	}
+
+	if uint64(len(out)) != n {
+		return nil, ErrCorrupt
+	}
+
+	return out, nil
+}
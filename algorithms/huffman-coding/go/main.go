@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fr3fou/random-stuff/algorithms/huffman-coding/go/huffman"
+)
+
+func main() {
+	text := []byte("this is an example of a huffman tree, this is an example of a huffman tree")
+
+	encoded := huffman.Encode(text)
+	decoded, err := huffman.Decode(encoded)
+	if err != nil {
+		panic(err)
+	}
+
+	// the 256 byte header dominates on tiny inputs
+	fmt.Println(len(text), len(encoded), len(encoded)-256)
+	fmt.Println(string(decoded))
+}
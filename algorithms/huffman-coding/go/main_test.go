@@ -0,0 +1,93 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/fr3fou/random-stuff/algorithms/huffman-coding/go/huffman"
+)
+
+var text = randomWords(1 << 20)
+
+func TestRoundTrip(t *testing.T) {
+	inputs := [][]byte{
+		{},
+		[]byte("a"),
+		[]byte("aaaaaaaa"),
+		[]byte("this is an example of a huffman tree"),
+		text[:4096],
+	}
+
+	all := make([]byte, 256)
+	for i := range all {
+		all[i] = byte(i)
+	}
+	inputs = append(inputs, all)
+
+	for _, input := range inputs {
+		decoded, err := huffman.Decode(huffman.Encode(input))
+		if err != nil {
+			t.Fatalf("decoding %q: %v", input, err)
+		}
+		if !bytes.Equal(decoded, input) {
+			t.Errorf("expected %q, got %q", input, decoded)
+		}
+	}
+}
+
+func TestDecodeCorrupt(t *testing.T) {
+	huge := binary.AppendUvarint(nil, 1<<62)
+	huge = append(huge, make([]byte, 256)...)
+
+	truncated := huffman.Encode([]byte("this is an example of a huffman tree"))
+	truncated = truncated[:len(truncated)-2]
+
+	for name, input := range map[string][]byte{
+		"empty":     {},
+		"no header": {5},
+		"huge size": huge,
+		"truncated": truncated,
+	} {
+		if _, err := huffman.Decode(input); err != huffman.ErrCorrupt {
+			t.Errorf("%s: expected ErrCorrupt, got %v", name, err)
+		}
+	}
+}
+
+func randomWords(n int) []byte {
+	r := rand.New(rand.NewSource(1))
+	words := strings.Fields("the quick brown fox jumps over the lazy dog while a huffman tree grows")
+
+	var b bytes.Buffer
+	for b.Len() < n {
+		b.WriteString(words[r.Intn(len(words))])
+		b.WriteByte(' ')
+	}
+
+	return b.Bytes()
+}
+
+func BenchmarkHuffman(b *testing.B) {
+	var out []byte
+	for i := 0; i < b.N; i++ {
+		out = huffman.Encode(text)
+	}
+
+	b.ReportMetric(float64(len(out))/float64(len(text)), "ratio")
+}
+
+func BenchmarkGzip(b *testing.B) {
+	var out bytes.Buffer
+	for i := 0; i < b.N; i++ {
+		out.Reset()
+		w := gzip.NewWriter(&out)
+		w.Write(text)
+		w.Close()
+	}
+
+	b.ReportMetric(float64(out.Len())/float64(len(text)), "ratio")
+}
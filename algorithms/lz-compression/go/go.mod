@@ -0,0 +1,3 @@
+module github.com/fr3fou/random-stuff/algorithms/lz-compression/go
+
+go 1.21
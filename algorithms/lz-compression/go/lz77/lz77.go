@@ -0,0 +1,103 @@
+// Package lz77 implements LZ77 compression, replacing repeated content with
+// (offset, length, next) back references
+package lz77
+
+import "errors"
+
+const (
+	// WindowSize is how far back a match may start
+	WindowSize = 4095
+	// MaxLength is the longest match a single token can copy
+	MaxLength = 255
+)
+
+// ErrCorrupt is returned when decompressing data which wasn't produced by Compress
+var ErrCorrupt = errors.New("lz77: corrupt input")
+
+// Token copies Length bytes starting Offset bytes back and then appends Next
+type Token struct {
+	Offset int
+	Length int
+	Next   byte
+}
+
+// Tokenize turns data into LZ77 tokens, always picking the longest match in
+// the window - this is O(n * WindowSize * MaxLength) in the worst case
+func Tokenize(data []byte) []Token {
+	tokens := []Token{}
+
+	for i := 0; i < len(data); {
+		best := Token{}
+
+		start := i - WindowSize
+		if start < 0 {
+			start = 0
+		}
+
+		// keep one byte for Next, so every token ends with a literal
+		limit := len(data) - 1 - i
+		if limit > MaxLength {
+			limit = MaxLength
+		}
+
+		for j := start; j < i; j++ {
+			l := 0
+			// the match may run past i and into itself, which is how runs are encoded
+			for l < limit && data[j+l] == data[i+l] {
+				l++
+			}
+
+			if l > best.Length {
+				best.Offset, best.Length = i-j, l
+			}
+		}
+
+		best.Next = data[i+best.Length]
+		tokens = append(tokens, best)
+		i += best.Length + 1
+	}
+
+	return tokens
+}
+
+// Compress encodes data as 4 byte tokens: 12 bits of offset, 12 bits of length and the next byte
+func Compress(data []byte) []byte {
+	out := []byte{}
+	for _, t := range Tokenize(data) {
+		out = append(out,
+			byte(t.Offset>>4),
+			byte(t.Offset<<4)|byte(t.Length>>8),
+			byte(t.Length),
+			t.Next,
+		)
+	}
+
+	return out
+}
+
+// Decompress reverses Compress
+func Decompress(data []byte) ([]byte, error) {
+	if len(data)%4 != 0 {
+		return nil, ErrCorrupt
+	}
+
+	out := []byte{}
+	for i := 0; i < len(data); i += 4 {
+		offset := int(data[i])<<4 | int(data[i+1])>>4
+		length := int(data[i+1]&0xf)<<8 | int(data[i+2])
+
+		if length > 0 && (offset == 0 || offset > len(out)) {
+			return nil, ErrCorrupt
+		}
+
+		// copy byte by byte since the source may overlap what we are writing
+		start := len(out) - offset
+		for j := 0; j < length; j++ {
+			out = append(out, out[start+j])
+		}
+
+		out = append(out, data[i+3])
+	}
+
+	return out, nil
+}
@@ -0,0 +1,137 @@
+// Package lzw implements Lempel-Ziv-Welch compression, where the dictionary
+// is rebuilt from the codes instead of being stored
+package lzw
+
+import (
+	"errors"
+	"math/bits"
+)
+
+// MaxCodes is the dictionary size, after which no new entries are added
+const MaxCodes = 1 << 12
+
+// ErrCorrupt is returned when decompressing data which wasn't produced by Compress
+var ErrCorrupt = errors.New("lzw: corrupt input")
+
+// width returns how many bits are needed for codes up to and including code,
+// starting at 9 as codes above 255 show up immediately
+func width(code int) int {
+	if code >= MaxCodes {
+		code = MaxCodes - 1
+	}
+
+	if w := bits.Len(uint(code)); w > 9 {
+		return w
+	}
+	return 9
+}
+
+type bitWriter struct {
+	out  []byte
+	acc  uint64
+	used int
+}
+
+func (w *bitWriter) write(code, n int) {
+	w.acc = w.acc<<n | uint64(code)
+	w.used += n
+
+	for w.used >= 8 {
+		w.used -= 8
+		w.out = append(w.out, byte(w.acc>>w.used))
+	}
+}
+
+func (w *bitWriter) flush() []byte {
+	if w.used > 0 {
+		w.out = append(w.out, byte(w.acc<<(8-w.used)))
+	}
+
+	return w.out
+}
+
+// Compress encodes data as a stream of variable width codes, packed MSB first
+func Compress(data []byte) []byte {
+	dict := map[string]int{}
+	for i := 0; i < 256; i++ {
+		dict[string([]byte{byte(i)})] = i
+	}
+
+	w := &bitWriter{}
+	next := 256
+
+	var current []byte
+	for _, b := range data {
+		candidate := append(current, b)
+		if _, ok := dict[string(candidate)]; ok {
+			current = candidate
+			continue
+		}
+
+		w.write(dict[string(current)], width(next-1))
+		if next < MaxCodes {
+			dict[string(candidate)] = next
+			next++
+		}
+
+		current = []byte{b}
+	}
+
+	if len(current) > 0 {
+		w.write(dict[string(current)], width(next-1))
+	}
+
+	return w.flush()
+}
+
+// Decompress reverses Compress
+func Decompress(data []byte) ([]byte, error) {
+	dict := make([][]byte, 256, MaxCodes)
+	for i := range dict {
+		dict[i] = []byte{byte(i)}
+	}
+
+	out := []byte{}
+
+	var (
+		prev []byte
+		acc  uint64
+		used int
+	)
+	for _, b := range data {
+		acc = acc<<8 | uint64(b)
+		used += 8
+
+		// the decoder is one entry behind the encoder, so the code it reads
+		// may be the one it is about to add
+		n := width(len(dict))
+		if prev == nil {
+			n = width(len(dict) - 1)
+		}
+		if used < n {
+			continue
+		}
+
+		used -= n
+		code := int(acc >> used & (1<<n - 1))
+
+		var entry []byte
+		switch {
+		case code < len(dict):
+			entry = dict[code]
+		case code == len(dict) && prev != nil:
+			// the KwKwK case: the entry is prev followed by its own first byte
+			entry = append(append([]byte{}, prev...), prev[0])
+		default:
+			return nil, ErrCorrupt
+		}
+
+		out = append(out, entry...)
+		if prev != nil && len(dict) < MaxCodes {
+			dict = append(dict, append(append([]byte{}, prev...), entry[0]))
+		}
+		prev = entry
+	}
+
+	return out, nil
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fr3fou/random-stuff/algorithms/lz-compression/go/lz77"
+	"github.com/fr3fou/random-stuff/algorithms/lz-compression/go/lzw"
+)
+
+func main() {
+	text := []byte("TOBEORNOTTOBEORTOBEORNOT#TOBEORNOTTOBEORTOBEORNOT")
+
+	fmt.Println(lz77.Tokenize([]byte("abababababc")))
+
+	for _, codec := range []struct {
+		name       string
+		compress   func([]byte) []byte
+		decompress func([]byte) ([]byte, error)
+	}{
+		{"lz77", lz77.Compress, lz77.Decompress},
+		{"lzw", lzw.Compress, lzw.Decompress},
+	} {
+		compressed := codec.compress(text)
+		decompressed, err := codec.decompress(compressed)
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Println(codec.name, len(text), len(compressed), string(decompressed) == string(text))
+	}
+}
@@ -0,0 +1,3 @@
+module reservoir-sampling
+
+go 1.21
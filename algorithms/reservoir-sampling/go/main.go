@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"math/rand"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	root := "."
+	if len(os.Args) > 1 {
+		root = os.Args[1]
+	}
+
+	r := newReservoir[string](5, rand.New(rand.NewSource(42)))
+
+	// the amount of files isn't known up front, so they are sampled as the walk goes
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			r.add(path)
+		}
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("picked %d out of %d files\n", len(r.items), r.seen)
+	for _, path := range r.items {
+		fmt.Println(path)
+	}
+}
+
+// reservoir keeps a uniform random sample of n items out of a stream of
+// unknown length using O(n) memory (Algorithm R)
+type reservoir[T any] struct {
+	items []T
+	n     int
+	seen  int
+	rand  *rand.Rand
+}
+
+func newReservoir[T any](n int, r *rand.Rand) *reservoir[T] {
+	return &reservoir[T]{items: make([]T, 0, n), n: n, rand: r}
+}
+
+func (r *reservoir[T]) add(v T) {
+	r.seen++
+
+	if len(r.items) < r.n {
+		r.items = append(r.items, v)
+		return
+	}
+
+	// the i-th item replaces a random one with probability n/i, which keeps
+	// every item seen so far in the sample with the same probability
+	if j := r.rand.Intn(r.seen); j < r.n {
+		r.items[j] = v
+	}
+}
@@ -0,0 +1,108 @@
+// Package boyermoore implements Boyer-Moore substring search, which compares
+// the pattern right to left and skips ahead on mismatches
+package boyermoore
+
+// Searcher is a pattern preprocessed for repeated searches
+type Searcher struct {
+	pattern []byte
+	last    [256]int // last index of every byte in the pattern, or -1
+	shift   []int    // good suffix shift for a mismatch right before index i
+}
+
+// New preprocesses pattern - this is O(len(pattern))
+func New(pattern []byte) *Searcher {
+	s := &Searcher{pattern: pattern}
+
+	for i := range s.last {
+		s.last[i] = -1
+	}
+	for i, b := range pattern {
+		s.last[b] = i
+	}
+
+	m := len(pattern)
+	s.shift = make([]int, m+1)
+	border := make([]int, m+1)
+
+	// the matched suffix occurs somewhere else in the pattern
+	i, j := m, m+1
+	border[i] = j
+	for i > 0 {
+		for j <= m && pattern[i-1] != pattern[j-1] {
+			if s.shift[j] == 0 {
+				s.shift[j] = j - i
+			}
+			j = border[j]
+		}
+		i--
+		j--
+		border[i] = j
+	}
+
+	// only part of the matched suffix occurs as a prefix of the pattern
+	j = border[0]
+	for i := 0; i <= m; i++ {
+		if s.shift[i] == 0 {
+			s.shift[i] = j
+		}
+		if i == j {
+			j = border[j]
+		}
+	}
+
+	return s
+}
+
+// Index returns the index of the first occurrence of the pattern in text, or -1
+func (s *Searcher) Index(text []byte) int {
+	m := len(s.pattern)
+	if m == 0 {
+		return 0
+	}
+
+	for pos := 0; pos <= len(text)-m; {
+		j := m - 1
+		for j >= 0 && s.pattern[j] == text[pos+j] {
+			j--
+		}
+
+		if j < 0 {
+			return pos
+		}
+
+		pos += max(s.shift[j+1], j-s.last[text[pos+j]])
+	}
+
+	return -1
+}
+
+// IndexAll returns the indices of all (possibly overlapping) occurrences of the pattern in text
+func (s *Searcher) IndexAll(text []byte) []int {
+	m := len(s.pattern)
+	if m == 0 {
+		return nil
+	}
+
+	var indices []int
+	for pos := 0; pos <= len(text)-m; {
+		j := m - 1
+		for j >= 0 && s.pattern[j] == text[pos+j] {
+			j--
+		}
+
+		if j < 0 {
+			indices = append(indices, pos)
+			pos += s.shift[0]
+			continue
+		}
+
+		pos += max(s.shift[j+1], j-s.last[text[pos+j]])
+	}
+
+	return indices
+}
+
+// Index returns the index of the first occurrence of pattern in text, or -1
+func Index(text, pattern []byte) int {
+	return New(pattern).Index(text)
+}
@@ -0,0 +1,3 @@
+module github.com/fr3fou/random-stuff/algorithms/substring-search/go
+
+go 1.21
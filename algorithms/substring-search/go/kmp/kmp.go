@@ -0,0 +1,81 @@
+// Package kmp implements Knuth-Morris-Pratt substring search, which never
+// compares a byte of the text twice - this is O(n + m)
+package kmp
+
+// Searcher is a pattern preprocessed for repeated searches
+type Searcher struct {
+	pattern []byte
+	prefix  []int // prefix[i] is the longest border of pattern[:i+1]
+}
+
+// New preprocesses pattern - this is O(len(pattern))
+func New(pattern []byte) *Searcher {
+	prefix := make([]int, len(pattern))
+
+	k := 0
+	for i := 1; i < len(pattern); i++ {
+		for k > 0 && pattern[i] != pattern[k] {
+			k = prefix[k-1]
+		}
+		if pattern[i] == pattern[k] {
+			k++
+		}
+		prefix[i] = k
+	}
+
+	return &Searcher{pattern: pattern, prefix: prefix}
+}
+
+// Index returns the index of the first occurrence of the pattern in text, or -1
+func (s *Searcher) Index(text []byte) int {
+	if len(s.pattern) == 0 {
+		return 0
+	}
+
+	k := 0
+	for i, b := range text {
+		for k > 0 && b != s.pattern[k] {
+			k = s.prefix[k-1]
+		}
+		if b == s.pattern[k] {
+			k++
+		}
+
+		if k == len(s.pattern) {
+			return i + 1 - k
+		}
+	}
+
+	return -1
+}
+
+// IndexAll returns the indices of all (possibly overlapping) occurrences of the pattern in text
+func (s *Searcher) IndexAll(text []byte) []int {
+	if len(s.pattern) == 0 {
+		return nil
+	}
+
+	var indices []int
+
+	k := 0
+	for i, b := range text {
+		for k > 0 && b != s.pattern[k] {
+			k = s.prefix[k-1]
+		}
+		if b == s.pattern[k] {
+			k++
+		}
+
+		if k == len(s.pattern) {
+			indices = append(indices, i+1-k)
+			k = s.prefix[k-1]
+		}
+	}
+
+	return indices
+}
+
+// Index returns the index of the first occurrence of pattern in text, or -1
+func Index(text, pattern []byte) int {
+	return New(pattern).Index(text)
+}
@@ -0,0 +1,17 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fr3fou/random-stuff/algorithms/substring-search/go/boyermoore"
+	"github.com/fr3fou/random-stuff/algorithms/substring-search/go/kmp"
+)
+
+func main() {
+	text := []byte("here is a simple example, an example of a simple search")
+	pattern := []byte("example")
+
+	fmt.Println(kmp.New(pattern).IndexAll(text))
+	fmt.Println(boyermoore.New(pattern).IndexAll(text))
+	fmt.Println(kmp.Index(text, []byte("missing")), boyermoore.Index(text, []byte("missing")))
+}
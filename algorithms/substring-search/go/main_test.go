@@ -0,0 +1,49 @@
+package main
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/fr3fou/random-stuff/algorithms/substring-search/go/boyermoore"
+	"github.com/fr3fou/random-stuff/algorithms/substring-search/go/kmp"
+)
+
+var (
+	text    = randomText(1 << 20)
+	pattern = []byte("the needle we are looking for")
+)
+
+func randomText(n int) []byte {
+	r := rand.New(rand.NewSource(1))
+	alphabet := "abcdefghijklmnopqrstuvwxyz "
+
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = alphabet[r.Intn(len(alphabet))]
+	}
+
+	// put the pattern at the very end so the whole text is scanned
+	copy(b[n-len(pattern):], pattern)
+	return b
+}
+
+func BenchmarkKMP(b *testing.B) {
+	s := kmp.New(pattern)
+	for i := 0; i < b.N; i++ {
+		s.Index(text)
+	}
+}
+
+func BenchmarkBoyerMoore(b *testing.B) {
+	s := boyermoore.New(pattern)
+	for i := 0; i < b.N; i++ {
+		s.Index(text)
+	}
+}
+
+func BenchmarkBytesIndex(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		bytes.Index(text, pattern)
+	}
+}
@@ -0,0 +1,3 @@
+module top-n-selection
+
+go 1.21
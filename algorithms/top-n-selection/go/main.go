@@ -0,0 +1,138 @@
+package main
+
+import (
+	"container/heap"
+	"fmt"
+	"io/fs"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+type file struct {
+	path string
+	size int64
+}
+
+func main() {
+	root := "."
+	if len(os.Args) > 1 {
+		root = os.Args[1]
+	}
+
+	files := []file{}
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		files = append(files, file{path, info.Size()})
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, f := range largestHeap(files, 5) {
+		fmt.Println(f.size, f.path)
+	}
+	fmt.Println()
+	for _, f := range largestSelect(files, 5) {
+		fmt.Println(f.size, f.path)
+	}
+}
+
+// minHeap keeps the smallest of the current top n on top, so it is the one
+// to kick out when a bigger file shows up
+type minHeap []file
+
+func (h minHeap) Len() int           { return len(h) }
+func (h minHeap) Less(i, j int) bool { return h[i].size < h[j].size }
+func (h minHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *minHeap) Push(x any)        { *h = append(*h, x.(file)) }
+func (h *minHeap) Pop() any {
+	old := *h
+	f := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return f
+}
+
+// largestHeap returns the n largest files, biggest first, keeping only n files
+// in memory - this is O(len(files) * log n) and works on a stream
+func largestHeap(files []file, n int) []file {
+	if n <= 0 {
+		return nil
+	}
+
+	h := &minHeap{}
+	for _, f := range files {
+		if h.Len() < n {
+			heap.Push(h, f)
+		} else if f.size > (*h)[0].size {
+			(*h)[0] = f
+			heap.Fix(h, 0)
+		}
+	}
+
+	top := make([]file, h.Len())
+	for i := len(top) - 1; i >= 0; i-- {
+		top[i] = heap.Pop(h).(file)
+	}
+
+	return top
+}
+
+// largestSelect returns the n largest files, biggest first, using quickselect
+// to partition them around the n-th largest - this is O(len(files) + n log n)
+// on average but needs all files up front
+func largestSelect(files []file, n int) []file {
+	if n <= 0 {
+		return nil
+	}
+	if n > len(files) {
+		n = len(files)
+	}
+
+	a := append([]file{}, files...)
+	lo, hi := 0, len(a)-1
+	for lo < hi {
+		p := partition(a, lo, hi)
+		switch {
+		case p == n-1:
+			lo = hi
+		case p < n-1:
+			lo = p + 1
+		default:
+			hi = p - 1
+		}
+	}
+
+	top := a[:n]
+	sort.Slice(top, func(i, j int) bool { return top[i].size > top[j].size })
+
+	return top
+}
+
+// partition moves everything bigger than a random pivot to its left and
+// returns where the pivot ended up
+func partition(a []file, lo, hi int) int {
+	p := lo + rand.Intn(hi-lo+1)
+	a[p], a[hi] = a[hi], a[p]
+
+	i := lo
+	for j := lo; j < hi; j++ {
+		if a[j].size > a[hi].size {
+			a[i], a[j] = a[j], a[i]
+			i++
+		}
+	}
+	a[i], a[hi] = a[hi], a[i]
+
+	return i
+}
@@ -0,0 +1,106 @@
+package bloom
+
+import (
+	"hash/fnv"
+	"math"
+)
+
+// maxHashes caps the amount of hash functions NewWithEstimates picks
+const maxHashes = 64
+
+// Filter is a probabilistic set - it can tell you that an element is
+// definitely not in the set or that it possibly is
+type Filter struct {
+	bits []uint64
+	m    uint64
+	k    uint64
+}
+
+// New returns a filter with m bits and k hash functions
+func New(m, k uint64) *Filter {
+	if m == 0 {
+		m = 1
+	}
+	if k == 0 {
+		k = 1
+	}
+
+	return &Filter{
+		bits: make([]uint64, (m+63)/64),
+		m:    m,
+		k:    k,
+	}
+}
+
+// NewWithEstimates returns a filter sized for n elements with a false positive
+// rate of p - n is at least 1 and p is clamped between 1e-9 and 0.5
+func NewWithEstimates(n uint64, p float64) *Filter {
+	if n < 1 {
+		n = 1
+	}
+	if !(p >= 1e-9) {
+		p = 1e-9
+	}
+	if p > 0.5 {
+		p = 0.5
+	}
+
+	m := math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2))
+	k := math.Round(m / float64(n) * math.Ln2)
+	k = math.Max(1, math.Min(k, maxHashes))
+
+	return New(uint64(m), uint64(k))
+}
+
+// hashes returns two independent hashes of data, which are combined to
+// simulate k hash functions (Kirsch-Mitzenmacher)
+func hashes(data []byte) (uint64, uint64) {
+	h := fnv.New64a()
+	h.Write(data)
+	a := h.Sum64()
+
+	h = fnv.New64()
+	h.Write(data)
+	b := h.Sum64() | 1 // make sure the step is never 0
+
+	return a, b
+}
+
+// Add puts data in the filter
+func (f *Filter) Add(data []byte) {
+	a, b := hashes(data)
+	for i := uint64(0); i < f.k; i++ {
+		bit := (a + i*b) % f.m
+		f.bits[bit/64] |= 1 << (bit % 64)
+	}
+}
+
+// AddString puts s in the filter
+func (f *Filter) AddString(s string) {
+	f.Add([]byte(s))
+}
+
+// Contains reports whether data might be in the filter - false means it definitely isn't
+func (f *Filter) Contains(data []byte) bool {
+	a, b := hashes(data)
+	for i := uint64(0); i < f.k; i++ {
+		bit := (a + i*b) % f.m
+		if f.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ContainsString reports whether s might be in the filter
+func (f *Filter) ContainsString(s string) bool {
+	return f.Contains([]byte(s))
+}
+
+// Clear removes all elements from the filter
+func (f *Filter) Clear() {
+	for i := range f.bits {
+		f.bits[i] = 0
+	}
+}
@@ -0,0 +1,16 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fr3fou/random-stuff/data-structures/bloom/go/bloom"
+)
+
+func main() {
+	f := bloom.NewWithEstimates(1000, 0.01)
+	f.AddString("/usr/local/bin")
+	f.AddString("/home/fr3fou")
+
+	fmt.Println(f.ContainsString("/usr/local/bin"))
+	fmt.Println(f.ContainsString("/etc/passwd"))
+}
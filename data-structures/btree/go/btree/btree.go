@@ -0,0 +1,291 @@
+package btree
+
+import (
+	"cmp"
+	"slices"
+)
+
+type node[K cmp.Ordered, V any] struct {
+	keys     []K
+	values   []V
+	children []*node[K, V]
+}
+
+func (n *node[K, V]) leaf() bool {
+	return len(n.children) == 0
+}
+
+// find returns the index of the first key >= k and whether it is equal to k
+func (n *node[K, V]) find(k K) (int, bool) {
+	return slices.BinarySearch(n.keys, k)
+}
+
+// BTree is an ordered map where every node holds between degree-1 and
+// 2*degree-1 keys, keeping the tree shallow and all operations O(log n)
+type BTree[K cmp.Ordered, V any] struct {
+	root   *node[K, V]
+	degree int
+	size   int
+}
+
+// New returns an empty B-tree with the given minimum degree (at least 2)
+func New[K cmp.Ordered, V any](degree int) *BTree[K, V] {
+	if degree < 2 {
+		degree = 2
+	}
+
+	return &BTree[K, V]{
+		root:   &node[K, V]{},
+		degree: degree,
+	}
+}
+
+// Get returns the value stored for k
+func (t *BTree[K, V]) Get(k K) (V, bool) {
+	n := t.root
+	for {
+		i, found := n.find(k)
+		if found {
+			return n.values[i], true
+		}
+
+		if n.leaf() {
+			var zero V
+			return zero, false
+		}
+
+		n = n.children[i]
+	}
+}
+
+// Put sets the value for k, replacing any previous one
+func (t *BTree[K, V]) Put(k K, v V) {
+	if len(t.root.keys) == 2*t.degree-1 {
+		t.root = &node[K, V]{children: []*node[K, V]{t.root}}
+		t.split(t.root, 0)
+	}
+
+	if t.insert(t.root, k, v) {
+		t.size++
+	}
+}
+
+// split moves the upper half of the full child x.children[i] into a new
+// sibling and lifts the median key into x
+func (t *BTree[K, V]) split(x *node[K, V], i int) {
+	y := x.children[i]
+	mid := t.degree - 1
+
+	z := &node[K, V]{
+		keys:   slices.Clone(y.keys[mid+1:]),
+		values: slices.Clone(y.values[mid+1:]),
+	}
+	if !y.leaf() {
+		z.children = slices.Clone(y.children[mid+1:])
+		y.children = y.children[:mid+1]
+	}
+
+	x.keys = slices.Insert(x.keys, i, y.keys[mid])
+	x.values = slices.Insert(x.values, i, y.values[mid])
+	x.children = slices.Insert(x.children, i+1, z)
+
+	y.keys = y.keys[:mid]
+	y.values = y.values[:mid]
+}
+
+// insert puts k in the subtree rooted at the non-full node x, splitting full
+// nodes on the way down so there is always room to lift a median into
+func (t *BTree[K, V]) insert(x *node[K, V], k K, v V) bool {
+	for {
+		i, found := x.find(k)
+		if found {
+			x.values[i] = v
+			return false
+		}
+
+		if x.leaf() {
+			x.keys = slices.Insert(x.keys, i, k)
+			x.values = slices.Insert(x.values, i, v)
+			return true
+		}
+
+		if len(x.children[i].keys) == 2*t.degree-1 {
+			t.split(x, i)
+			switch {
+			case k == x.keys[i]:
+				x.values[i] = v
+				return false
+			case k > x.keys[i]:
+				i++
+			}
+		}
+
+		x = x.children[i]
+	}
+}
+
+// Delete removes k from the tree
+func (t *BTree[K, V]) Delete(k K) bool {
+	removed := t.delete(t.root, k)
+	if len(t.root.keys) == 0 && !t.root.leaf() {
+		t.root = t.root.children[0]
+	}
+
+	if removed {
+		t.size--
+	}
+
+	return removed
+}
+
+// delete removes k from the subtree rooted at x, making sure every node it
+// descends into has at least degree keys so removing one never underflows
+func (t *BTree[K, V]) delete(x *node[K, V], k K) bool {
+	i, found := x.find(k)
+
+	if found {
+		if x.leaf() {
+			x.keys = slices.Delete(x.keys, i, i+1)
+			x.values = slices.Delete(x.values, i, i+1)
+			return true
+		}
+
+		switch {
+		case len(x.children[i].keys) >= t.degree:
+			pk, pv := last(x.children[i])
+			x.keys[i], x.values[i] = pk, pv
+			return t.delete(x.children[i], pk)
+		case len(x.children[i+1].keys) >= t.degree:
+			sk, sv := first(x.children[i+1])
+			x.keys[i], x.values[i] = sk, sv
+			return t.delete(x.children[i+1], sk)
+		default:
+			t.merge(x, i)
+			return t.delete(x.children[i], k)
+		}
+	}
+
+	if x.leaf() {
+		return false
+	}
+
+	if len(x.children[i].keys) < t.degree {
+		i = t.fill(x, i)
+	}
+
+	return t.delete(x.children[i], k)
+}
+
+// fill makes sure x.children[i] has at least degree keys by borrowing from a
+// sibling or merging with one, returning the index of the child to descend into
+func (t *BTree[K, V]) fill(x *node[K, V], i int) int {
+	switch {
+	case i > 0 && len(x.children[i-1].keys) >= t.degree:
+		child, left := x.children[i], x.children[i-1]
+		end := len(left.keys) - 1
+
+		child.keys = slices.Insert(child.keys, 0, x.keys[i-1])
+		child.values = slices.Insert(child.values, 0, x.values[i-1])
+		x.keys[i-1], x.values[i-1] = left.keys[end], left.values[end]
+		left.keys, left.values = left.keys[:end], left.values[:end]
+
+		if !left.leaf() {
+			child.children = slices.Insert(child.children, 0, left.children[end+1])
+			left.children = left.children[:end+1]
+		}
+		return i
+	case i < len(x.keys) && len(x.children[i+1].keys) >= t.degree:
+		child, right := x.children[i], x.children[i+1]
+
+		child.keys = append(child.keys, x.keys[i])
+		child.values = append(child.values, x.values[i])
+		x.keys[i], x.values[i] = right.keys[0], right.values[0]
+		right.keys = slices.Delete(right.keys, 0, 1)
+		right.values = slices.Delete(right.values, 0, 1)
+
+		if !right.leaf() {
+			child.children = append(child.children, right.children[0])
+			right.children = slices.Delete(right.children, 0, 1)
+		}
+		return i
+	case i < len(x.keys):
+		t.merge(x, i)
+		return i
+	default:
+		t.merge(x, i-1)
+		return i - 1
+	}
+}
+
+// merge joins x.children[i], the separator key i and x.children[i+1] into one node
+func (t *BTree[K, V]) merge(x *node[K, V], i int) {
+	left, right := x.children[i], x.children[i+1]
+
+	left.keys = append(append(left.keys, x.keys[i]), right.keys...)
+	left.values = append(append(left.values, x.values[i]), right.values...)
+	left.children = append(left.children, right.children...)
+
+	x.keys = slices.Delete(x.keys, i, i+1)
+	x.values = slices.Delete(x.values, i, i+1)
+	x.children = slices.Delete(x.children, i+1, i+2)
+}
+
+// first returns the smallest element in the subtree rooted at n
+func first[K cmp.Ordered, V any](n *node[K, V]) (K, V) {
+	for !n.leaf() {
+		n = n.children[0]
+	}
+
+	return n.keys[0], n.values[0]
+}
+
+// last returns the largest element in the subtree rooted at n
+func last[K cmp.Ordered, V any](n *node[K, V]) (K, V) {
+	for !n.leaf() {
+		n = n.children[len(n.children)-1]
+	}
+
+	i := len(n.keys) - 1
+	return n.keys[i], n.values[i]
+}
+
+// Ascend calls fn for every element in ascending key order, stopping early if fn returns false
+func (t *BTree[K, V]) Ascend(fn func(k K, v V) bool) {
+	ascend(t.root, nil, fn)
+}
+
+// AscendFrom calls fn for every element with a key >= from in ascending order
+func (t *BTree[K, V]) AscendFrom(from K, fn func(k K, v V) bool) {
+	ascend(t.root, &from, fn)
+}
+
+func ascend[K cmp.Ordered, V any](n *node[K, V], from *K, fn func(K, V) bool) bool {
+	start := 0
+	if from != nil {
+		start, _ = n.find(*from)
+	}
+
+	for i := start; i < len(n.keys); i++ {
+		if !n.leaf() && !ascend(n.children[i], from, fn) {
+			return false
+		}
+
+		if !fn(n.keys[i], n.values[i]) {
+			return false
+		}
+
+		// everything to the right of the first key >= from is also >= from
+		from = nil
+	}
+
+	if !n.leaf() {
+		return ascend(n.children[len(n.keys)], from, fn)
+	}
+
+	return true
+}
+
+// Length returns the amount of elements in the tree
+func (t *BTree[K, V]) Length() int {
+	return t.size
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fr3fou/random-stuff/data-structures/btree/go/btree"
+)
+
+func main() {
+	t := btree.New[string, int](2)
+	for i, name := range []string{"usr", "etc", "home", "var", "bin", "tmp", "opt", "lib"} {
+		t.Put(name, i)
+	}
+
+	t.Delete("home")
+	t.Ascend(func(k string, v int) bool {
+		fmt.Println(k, v)
+		return true
+	})
+
+	fmt.Println(t.Get("var"))
+	fmt.Println(t.Length())
+}
@@ -0,0 +1,161 @@
+package consistenthash
+
+import (
+	"hash/fnv"
+	"slices"
+	"sort"
+	"strconv"
+)
+
+// Ring maps keys to nodes by placing both on a circle of hashes - a key
+// belongs to the first node clockwise from it. Adding or removing a node only
+// moves the keys of its neighbouring arc instead of reshuffling everything
+type Ring struct {
+	replicas int
+	hashes   []uint32 // sorted positions of every virtual node
+	claims   map[uint32][]string
+	nodes    map[string]bool
+}
+
+// New returns an empty ring where every node is placed replicas times, which
+// evens out how many keys each node gets
+func New(replicas int) *Ring {
+	if replicas < 1 {
+		replicas = 1
+	}
+
+	return &Ring{
+		replicas: replicas,
+		claims:   map[uint32][]string{},
+		nodes:    map[string]bool{},
+	}
+}
+
+func hash(s string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(s))
+	return h.Sum32()
+}
+
+// positions returns where the virtual nodes of node sit on the ring
+func (r *Ring) positions(node string) []uint32 {
+	positions := make([]uint32, r.replicas)
+	for i := range positions {
+		positions[i] = hash(strconv.Itoa(i) + node)
+	}
+
+	return positions
+}
+
+// owner returns the node owning position h - when virtual nodes collide, the
+// smallest node name wins so the ring doesn't depend on the order of Add calls
+func (r *Ring) owner(h uint32) string {
+	return r.claims[h][0]
+}
+
+// Add places nodes on the ring
+func (r *Ring) Add(nodes ...string) {
+	for _, node := range nodes {
+		if r.nodes[node] {
+			continue
+		}
+		r.nodes[node] = true
+
+		for _, h := range r.positions(node) {
+			claims := r.claims[h]
+			if len(claims) == 0 {
+				r.hashes = append(r.hashes, h)
+			}
+
+			i, found := slices.BinarySearch(claims, node)
+			if !found {
+				r.claims[h] = slices.Insert(claims, i, node)
+			}
+		}
+	}
+
+	slices.Sort(r.hashes)
+}
+
+// Remove takes node off the ring, handing its keys to the next nodes clockwise
+// or to the node it collided with
+func (r *Ring) Remove(node string) {
+	if !r.nodes[node] {
+		return
+	}
+	delete(r.nodes, node)
+
+	for _, h := range r.positions(node) {
+		claims := r.claims[h]
+		if i, found := slices.BinarySearch(claims, node); found {
+			claims = slices.Delete(claims, i, i+1)
+		}
+
+		if len(claims) > 0 {
+			r.claims[h] = claims
+		} else {
+			delete(r.claims, h)
+		}
+	}
+
+	hashes := r.hashes[:0]
+	for _, h := range r.hashes {
+		if _, ok := r.claims[h]; ok {
+			hashes = append(hashes, h)
+		}
+	}
+	r.hashes = hashes
+}
+
+// search returns the index of the first virtual node clockwise from key
+func (r *Ring) search(key string) int {
+	h := hash(key)
+	i := sort.Search(len(r.hashes), func(i int) bool { return r.hashes[i] >= h })
+	if i == len(r.hashes) {
+		i = 0
+	}
+
+	return i
+}
+
+// Get returns the node key belongs to, or "" if the ring is empty - this is O(log n)
+func (r *Ring) Get(key string) string {
+	if len(r.hashes) == 0 {
+		return ""
+	}
+
+	return r.owner(r.hashes[r.search(key)])
+}
+
+// GetN returns up to n distinct nodes clockwise from key, e.g. for replication
+func (r *Ring) GetN(key string, n int) []string {
+	if n <= 0 || len(r.hashes) == 0 {
+		return nil
+	}
+
+	// a node which lost all of its positions to collisions can't be found,
+	// so stop after going around the ring once
+	nodes := make([]string, 0, n)
+	seen := map[string]bool{}
+	start := r.search(key)
+	for step := 0; step < len(r.hashes) && len(nodes) < n; step++ {
+		node := r.owner(r.hashes[(start+step)%len(r.hashes)])
+		if !seen[node] {
+			seen[node] = true
+			nodes = append(nodes, node)
+		}
+	}
+
+	return nodes
+}
+
+// Nodes returns the nodes on the ring in sorted order
+func (r *Ring) Nodes() []string {
+	nodes := make([]string, 0, len(r.nodes))
+	for node := range r.nodes {
+		nodes = append(nodes, node)
+	}
+	slices.Sort(nodes)
+
+	return nodes
+}
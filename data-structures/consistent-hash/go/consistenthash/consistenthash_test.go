@@ -0,0 +1,77 @@
+package consistenthash
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// these two names collide on their only virtual node with fnv32a
+const (
+	collidingA = "node-1590204"
+	collidingB = "node-983898"
+)
+
+func TestCollisionIsResolvedBySmallestName(t *testing.T) {
+	if hash("0"+collidingA) != hash("0"+collidingB) {
+		t.Fatal("expected the test nodes to collide")
+	}
+
+	for _, order := range [][]string{{collidingA, collidingB}, {collidingB, collidingA}} {
+		r := New(1)
+		r.Add(order...)
+
+		if got := r.Get("key"); got != collidingA {
+			t.Errorf("added %v: expected %s, got %s", order, collidingA, got)
+		}
+
+		// the loser has no position left, so only one node can be returned
+		if got := r.GetN("key", 2); !slices.Equal(got, []string{collidingA}) {
+			t.Errorf("added %v: expected [%s], got %v", order, collidingA, got)
+		}
+	}
+}
+
+func TestRemoveHandsBackCollidedSpot(t *testing.T) {
+	r := New(1)
+	r.Add(collidingA, collidingB)
+	r.Remove(collidingA)
+
+	if got := r.Get("key"); got != collidingB {
+		t.Errorf("expected %s, got %s", collidingB, got)
+	}
+
+	r.Remove(collidingB)
+	if got := r.Get("key"); got != "" {
+		t.Errorf("expected an empty ring, got %s", got)
+	}
+	if got := r.GetN("key", 2); got != nil {
+		t.Errorf("expected no nodes, got %v", got)
+	}
+}
+
+func TestRemoveOnlyMovesItsKeys(t *testing.T) {
+	r := New(50)
+	r.Add("a", "b", "c", "d")
+
+	before := map[string]string{}
+	for i := 0; i < 1000; i++ {
+		key := fmt.Sprint(i)
+		before[key] = r.Get(key)
+	}
+
+	r.Remove("c")
+	for key, owner := range before {
+		got := r.Get(key)
+		if owner != "c" && got != owner {
+			t.Errorf("%s moved from %s to %s", key, owner, got)
+		}
+		if got == "c" {
+			t.Errorf("%s still belongs to the removed node", key)
+		}
+	}
+
+	if got := r.GetN("x", 5); len(got) != 3 {
+		t.Errorf("expected 3 distinct nodes, got %v", got)
+	}
+}
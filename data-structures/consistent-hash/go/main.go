@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fr3fou/random-stuff/data-structures/consistent-hash/go/consistenthash"
+)
+
+func main() {
+	r := consistenthash.New(100)
+	r.Add("shard-a", "shard-b", "shard-c")
+
+	paths := []string{}
+	for i := 0; i < 1000; i++ {
+		paths = append(paths, fmt.Sprintf("/data/file-%d", i))
+	}
+
+	before := map[string]string{}
+	for _, p := range paths {
+		before[p] = r.Get(p)
+	}
+
+	// only the paths owned by the new shard should move
+	r.Add("shard-d")
+	moved := 0
+	for _, p := range paths {
+		if r.Get(p) != before[p] {
+			moved++
+		}
+	}
+
+	fmt.Println(moved, "of", len(paths), "paths moved")
+	fmt.Println(r.GetN("/data/file-1", 2))
+}
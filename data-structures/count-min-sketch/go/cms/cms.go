@@ -0,0 +1,125 @@
+package cms
+
+import (
+	"hash/fnv"
+	"math"
+)
+
+// Sketch approximately counts how many times every element was seen using a
+// fixed amount of memory. Counts are never underestimated, only overestimated
+// when elements collide in every row
+type Sketch struct {
+	rows  [][]uint64
+	width uint64
+	total uint64
+}
+
+// New returns a sketch with depth rows of width counters each
+func New(width, depth uint64) *Sketch {
+	if width == 0 {
+		width = 1
+	}
+	if depth == 0 {
+		depth = 1
+	}
+
+	rows := make([][]uint64, depth)
+	for i := range rows {
+		rows[i] = make([]uint64, width)
+	}
+
+	return &Sketch{rows: rows, width: width}
+}
+
+// NewWithEstimates returns a sketch whose counts are off by at most
+// epsilon * total with probability 1 - delta - epsilon is clamped between
+// 1e-6 and 1 and delta between 1e-9 and 0.5
+func NewWithEstimates(epsilon, delta float64) *Sketch {
+	epsilon = clamp(epsilon, 1e-6, 1)
+	delta = clamp(delta, 1e-9, 0.5)
+
+	width := math.Ceil(math.E / epsilon)
+	depth := math.Ceil(math.Log(1 / delta))
+
+	return New(uint64(width), uint64(depth))
+}
+
+// clamp keeps v between lo and hi, treating NaN as lo
+func clamp(v, lo, hi float64) float64 {
+	switch {
+	case math.IsNaN(v) || v < lo:
+		return lo
+	case v > hi:
+		return hi
+	}
+
+	return v
+}
+
+func hash(data []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(data)
+	return h.Sum64()
+}
+
+// column returns which counter of the given row h lands in - mixing the row
+// number into the hash makes every row collide on different elements
+func (s *Sketch) column(h uint64, row int) uint64 {
+	x := h + uint64(row+1)*0x9e3779b97f4a7c15
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+
+	return x % s.width
+}
+
+// Add records count occurrences of data - this is O(depth)
+func (s *Sketch) Add(data []byte, count uint64) {
+	h := hash(data)
+	for i, row := range s.rows {
+		row[s.column(h, i)] += count
+	}
+
+	s.total += count
+}
+
+// AddString records count occurrences of str
+func (s *Sketch) AddString(str string, count uint64) {
+	s.Add([]byte(str), count)
+}
+
+// Count returns the estimated amount of times data was added - this is the
+// smallest counter it maps to, since that one had the fewest collisions
+func (s *Sketch) Count(data []byte) uint64 {
+	h := hash(data)
+
+	estimate := uint64(math.MaxUint64)
+	for i, row := range s.rows {
+		if c := row[s.column(h, i)]; c < estimate {
+			estimate = c
+		}
+	}
+
+	return estimate
+}
+
+// CountString returns the estimated amount of times str was added
+func (s *Sketch) CountString(str string) uint64 {
+	return s.Count([]byte(str))
+}
+
+// Total returns the sum of all counts added to the sketch
+func (s *Sketch) Total() uint64 {
+	return s.total
+}
+
+// Clear resets every counter to 0
+func (s *Sketch) Clear() {
+	for _, row := range s.rows {
+		for i := range row {
+			row[i] = 0
+		}
+	}
+
+	s.total = 0
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fr3fou/random-stuff/data-structures/count-min-sketch/go/cms"
+)
+
+func main() {
+	s := cms.NewWithEstimates(0.001, 0.01)
+
+	accesses := []string{"/etc/hosts", "/usr/bin/go", "/etc/hosts", "/home/fr3fou", "/etc/hosts"}
+	for _, path := range accesses {
+		s.AddString(path, 1)
+	}
+
+	fmt.Println(s.CountString("/etc/hosts"))
+	fmt.Println(s.CountString("/usr/bin/go"))
+	fmt.Println(s.CountString("/var/log"))
+	fmt.Println(s.Total())
+}
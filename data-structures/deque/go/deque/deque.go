@@ -0,0 +1,110 @@
+package deque
+
+// Deque is a double-ended queue backed by a growable circular array, so both
+// ends can be pushed to and popped from in O(1)
+type Deque[T any] struct {
+	array  []T
+	head   int // index of the front element
+	length int
+}
+
+// New returns an empty deque
+func New[T any]() *Deque[T] {
+	return &Deque[T]{}
+}
+
+// grow doubles the capacity, unrolling the elements to start at index 0
+func (d *Deque[T]) grow() {
+	capacity := len(d.array) * 2
+	if capacity == 0 {
+		capacity = 8
+	}
+
+	array := make([]T, capacity)
+	for i := 0; i < d.length; i++ {
+		array[i] = d.array[(d.head+i)%len(d.array)]
+	}
+
+	d.array, d.head = array, 0
+}
+
+// PushFront adds an element to the front of the deque
+func (d *Deque[T]) PushFront(v T) {
+	if d.length == len(d.array) {
+		d.grow()
+	}
+
+	d.head = (d.head - 1 + len(d.array)) % len(d.array)
+	d.array[d.head] = v
+	d.length++
+}
+
+// PushBack adds an element to the back of the deque
+func (d *Deque[T]) PushBack(v T) {
+	if d.length == len(d.array) {
+		d.grow()
+	}
+
+	d.array[(d.head+d.length)%len(d.array)] = v
+	d.length++
+}
+
+// PopFront removes the front element and returns it
+func (d *Deque[T]) PopFront() (T, bool) {
+	var zero T
+	if d.length == 0 {
+		return zero, false
+	}
+
+	v := d.array[d.head]
+	d.array[d.head] = zero
+	d.head = (d.head + 1) % len(d.array)
+	d.length--
+
+	return v, true
+}
+
+// PopBack removes the back element and returns it
+func (d *Deque[T]) PopBack() (T, bool) {
+	var zero T
+	if d.length == 0 {
+		return zero, false
+	}
+
+	i := (d.head + d.length - 1) % len(d.array)
+	v := d.array[i]
+	d.array[i] = zero
+	d.length--
+
+	return v, true
+}
+
+// Front returns the front element without removing it
+func (d *Deque[T]) Front() (T, bool) {
+	return d.At(0)
+}
+
+// Back returns the back element without removing it
+func (d *Deque[T]) Back() (T, bool) {
+	return d.At(d.length - 1)
+}
+
+// At returns the i-th element from the front
+func (d *Deque[T]) At(i int) (T, bool) {
+	if i < 0 || i >= d.length {
+		var zero T
+		return zero, false
+	}
+
+	return d.array[(d.head+i)%len(d.array)], true
+}
+
+// Clear removes all elements from the deque
+func (d *Deque[T]) Clear() {
+	d.array, d.head, d.length = nil, 0, 0
+}
+
+// Length returns the amount of elements in the deque
+func (d *Deque[T]) Length() int {
+	return d.length
+}
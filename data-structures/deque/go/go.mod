@@ -0,0 +1,3 @@
+module github.com/fr3fou/random-stuff/data-structures/deque/go
+
+go 1.21
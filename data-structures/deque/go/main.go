@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fr3fou/random-stuff/data-structures/deque/go/deque"
+)
+
+type dir struct {
+	name     string
+	children []*dir
+}
+
+func main() {
+	root := &dir{"/", []*dir{
+		{"usr", []*dir{{"bin", nil}, {"lib", nil}}},
+		{"home", []*dir{{"fr3fou", nil}}},
+	}}
+
+	// the same loop is a BFS when popping from the front and a DFS when
+	// popping from the back - no recursion, so depth isn't limited by the stack
+	for _, bfs := range []bool{true, false} {
+		frontier := deque.New[*dir]()
+		frontier.PushBack(root)
+
+		for frontier.Length() > 0 {
+			var d *dir
+			if bfs {
+				d, _ = frontier.PopFront()
+			} else {
+				d, _ = frontier.PopBack()
+			}
+
+			fmt.Print(d.name, " ")
+			for _, c := range d.children {
+				frontier.PushBack(c)
+			}
+		}
+		fmt.Println()
+	}
+}
@@ -1,8 +1,198 @@
 package graph
 
-type Node struct {
-	Value int
-	Edges []*Node
+import "errors"
+
+// ErrCycle is returned when an operation requires the graph to be acyclic
+var ErrCycle = errors.New("graph: graph contains a cycle")
+
+// Graph is a directed graph stored as an adjacency list
+type Graph struct {
+	edges map[int][]int
+	nodes []int // insertion order, so traversals are deterministic
+}
+
+// New returns an empty graph
+func New() *Graph {
+	return &Graph{edges: map[int][]int{}}
+}
+
+// AddNode adds v to the graph if it isn't there already
+func (g *Graph) AddNode(v int) {
+	if _, ok := g.edges[v]; ok {
+		return
+	}
+
+	g.edges[v] = nil
+	g.nodes = append(g.nodes, v)
+}
+
+// AddEdge adds a directed edge from -> to, adding any missing nodes
+func (g *Graph) AddEdge(from, to int) {
+	g.AddNode(from)
+	g.AddNode(to)
+	g.edges[from] = append(g.edges[from], to)
+}
+
+// Nodes returns all nodes in the order they were added
+func (g *Graph) Nodes() []int {
+	return append([]int(nil), g.nodes...)
 }
 
-type Graph []*Node
+// Neighbours returns the nodes v has an edge to
+func (g *Graph) Neighbours(v int) []int {
+	return append([]int(nil), g.edges[v]...)
+}
+
+// DFS visits every node reachable from start depth first, stopping early if fn returns false
+func (g *Graph) DFS(start int, fn func(v int) bool) {
+	if _, ok := g.edges[start]; !ok {
+		return
+	}
+
+	visited := map[int]bool{}
+	stack := []int{start}
+
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if visited[v] {
+			continue
+		}
+		visited[v] = true
+
+		if !fn(v) {
+			return
+		}
+
+		// push in reverse so the first neighbour is visited first
+		next := g.edges[v]
+		for i := len(next) - 1; i >= 0; i-- {
+			if !visited[next[i]] {
+				stack = append(stack, next[i])
+			}
+		}
+	}
+}
+
+// BFS visits every node reachable from start breadth first, stopping early if fn returns false
+func (g *Graph) BFS(start int, fn func(v int) bool) {
+	if _, ok := g.edges[start]; !ok {
+		return
+	}
+
+	visited := map[int]bool{start: true}
+	queue := []int{start}
+
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+
+		if !fn(v) {
+			return
+		}
+
+		for _, n := range g.edges[v] {
+			if !visited[n] {
+				visited[n] = true
+				queue = append(queue, n)
+			}
+		}
+	}
+}
+
+const (
+	white = iota // not visited yet
+	grey         // on the current DFS path
+	black        // finished
+)
+
+// FindCycle returns the nodes of a cycle (with the first node repeated at the
+// end) or nil if the graph is acyclic
+func (g *Graph) FindCycle() []int {
+	colour := map[int]int{}
+	parent := map[int]int{}
+
+	var visit func(v int) []int
+	visit = func(v int) []int {
+		colour[v] = grey
+
+		for _, n := range g.edges[v] {
+			switch colour[n] {
+			case white:
+				parent[n] = v
+				if cycle := visit(n); cycle != nil {
+					return cycle
+				}
+			case grey:
+				// n is an ancestor of v, so walk back up to it
+				cycle := []int{n}
+				for u := v; u != n; u = parent[u] {
+					cycle = append(cycle, u)
+				}
+				cycle = append(cycle, n)
+
+				for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+					cycle[i], cycle[j] = cycle[j], cycle[i]
+				}
+				return cycle
+			}
+		}
+
+		colour[v] = black
+		return nil
+	}
+
+	for _, v := range g.nodes {
+		if colour[v] == white {
+			if cycle := visit(v); cycle != nil {
+				return cycle
+			}
+		}
+	}
+
+	return nil
+}
+
+// HasCycle checks if the graph contains a cycle
+func (g *Graph) HasCycle() bool {
+	return g.FindCycle() != nil
+}
+
+// TopologicalSort orders the nodes so every edge points forward, using Kahn's algorithm
+func (g *Graph) TopologicalSort() ([]int, error) {
+	indegree := map[int]int{}
+	for _, v := range g.nodes {
+		for _, n := range g.edges[v] {
+			indegree[n]++
+		}
+	}
+
+	queue := []int{}
+	for _, v := range g.nodes {
+		if indegree[v] == 0 {
+			queue = append(queue, v)
+		}
+	}
+
+	sorted := make([]int, 0, len(g.nodes))
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		sorted = append(sorted, v)
+
+		for _, n := range g.edges[v] {
+			indegree[n]--
+			if indegree[n] == 0 {
+				queue = append(queue, n)
+			}
+		}
+	}
+
+	// whatever is left is stuck behind a cycle
+	if len(sorted) != len(g.nodes) {
+		return nil, ErrCycle
+	}
+
+	return sorted, nil
+}
@@ -1,7 +1,27 @@
 package main
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/fr3fou/random-stuff/data-structures/graph/go/graph"
+)
 
 func main() {
-	fmt.Println("vim-go")
+	g := graph.New()
+	g.AddEdge(1, 2)
+	g.AddEdge(1, 3)
+	g.AddEdge(2, 4)
+	g.AddEdge(3, 4)
+
+	g.BFS(1, func(v int) bool {
+		fmt.Print(v, " ")
+		return true
+	})
+	fmt.Println()
+
+	fmt.Println(g.TopologicalSort())
+
+	g.AddEdge(4, 1)
+	fmt.Println(g.FindCycle())
+	fmt.Println(g.TopologicalSort())
 }
@@ -0,0 +1,3 @@
+module github.com/fr3fou/random-stuff/data-structures/hamt/go
+
+go 1.21
@@ -0,0 +1,254 @@
+package hamt
+
+import (
+	"hash/fnv"
+	"math/bits"
+	"slices"
+)
+
+const (
+	bitsPerLevel = 5
+	levelMask    = 1<<bitsPerLevel - 1
+	hashBits     = 64
+)
+
+// entry is either a key/value pair or a pointer to a deeper node
+type entry[V any] struct {
+	hash  uint64
+	key   string
+	value V
+	child *node[V]
+}
+
+// node holds only the entries whose bit is set in bitmap, so a level with a
+// couple of keys doesn't pay for 32 slots. Once the hash is exhausted keys
+// with the same hash end up in a collision node, which is a plain list
+type node[V any] struct {
+	bitmap     uint32
+	entries    []entry[V]
+	collisions bool
+}
+
+// Map is a persistent (immutable) hash array mapped trie. Every update returns
+// a new map which shares all untouched nodes with the old one, so keeping old
+// versions around is cheap
+type Map[V any] struct {
+	root *node[V]
+	size int
+}
+
+// New returns an empty map
+func New[V any]() *Map[V] {
+	return &Map[V]{root: &node[V]{}}
+}
+
+func hash(key string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	return h.Sum64()
+}
+
+// index returns the position of the entry for h at the given shift inside n
+// and whether such an entry exists
+func (n *node[V]) index(h uint64, shift uint) (int, uint32, bool) {
+	bit := uint32(1) << ((h >> shift) & levelMask)
+	return bits.OnesCount32(n.bitmap & (bit - 1)), bit, n.bitmap&bit != 0
+}
+
+// Get returns the value stored for key - this is O(log32 n)
+func (m *Map[V]) Get(key string) (V, bool) {
+	h := hash(key)
+	n := m.root
+
+	for shift := uint(0); ; shift += bitsPerLevel {
+		if n.collisions {
+			for _, e := range n.entries {
+				if e.key == key {
+					return e.value, true
+				}
+			}
+			break
+		}
+
+		pos, _, ok := n.index(h, shift)
+		if !ok {
+			break
+		}
+
+		e := n.entries[pos]
+		if e.child == nil {
+			if e.key == key {
+				return e.value, true
+			}
+			break
+		}
+
+		n = e.child
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Set returns a new map with key set to v, copying only the path to the key
+func (m *Map[V]) Set(key string, v V) *Map[V] {
+	root, added := set(m.root, 0, entry[V]{hash: hash(key), key: key, value: v})
+
+	size := m.size
+	if added {
+		size++
+	}
+
+	return &Map[V]{root: root, size: size}
+}
+
+func set[V any](n *node[V], shift uint, e entry[V]) (*node[V], bool) {
+	if n.collisions {
+		entries := slices.Clone(n.entries)
+		for i := range entries {
+			if entries[i].key == e.key {
+				entries[i] = e
+				return &node[V]{entries: entries, collisions: true}, false
+			}
+		}
+
+		return &node[V]{entries: append(entries, e), collisions: true}, true
+	}
+
+	pos, bit, ok := n.index(e.hash, shift)
+	if !ok {
+		return &node[V]{
+			bitmap:  n.bitmap | bit,
+			entries: slices.Insert(slices.Clone(n.entries), pos, e),
+		}, true
+	}
+
+	cp := &node[V]{bitmap: n.bitmap, entries: slices.Clone(n.entries)}
+	existing := n.entries[pos]
+
+	switch {
+	case existing.child != nil:
+		child, added := set(existing.child, shift+bitsPerLevel, e)
+		cp.entries[pos] = entry[V]{child: child}
+		return cp, added
+	case existing.key == e.key:
+		cp.entries[pos] = e
+		return cp, false
+	default:
+		cp.entries[pos] = entry[V]{child: pair(shift+bitsPerLevel, existing, e)}
+		return cp, true
+	}
+}
+
+// pair builds the smallest subtree holding both a and b
+func pair[V any](shift uint, a, b entry[V]) *node[V] {
+	if shift >= hashBits {
+		return &node[V]{entries: []entry[V]{a, b}, collisions: true}
+	}
+
+	ia, ib := (a.hash>>shift)&levelMask, (b.hash>>shift)&levelMask
+	if ia == ib {
+		return &node[V]{
+			bitmap:  1 << ia,
+			entries: []entry[V]{{child: pair(shift+bitsPerLevel, a, b)}},
+		}
+	}
+
+	if ia > ib {
+		a, b = b, a
+		ia, ib = ib, ia
+	}
+
+	return &node[V]{
+		bitmap:  1<<ia | 1<<ib,
+		entries: []entry[V]{a, b},
+	}
+}
+
+// Delete returns a new map without key
+func (m *Map[V]) Delete(key string) *Map[V] {
+	root, removed := del(m.root, 0, hash(key), key)
+	if !removed {
+		return m
+	}
+
+	return &Map[V]{root: root, size: m.size - 1}
+}
+
+func del[V any](n *node[V], shift uint, h uint64, key string) (*node[V], bool) {
+	if n.collisions {
+		for i, e := range n.entries {
+			if e.key == key {
+				return &node[V]{
+					entries:    slices.Delete(slices.Clone(n.entries), i, i+1),
+					collisions: true,
+				}, true
+			}
+		}
+
+		return n, false
+	}
+
+	pos, bit, ok := n.index(h, shift)
+	if !ok {
+		return n, false
+	}
+
+	e := n.entries[pos]
+	if e.child == nil {
+		if e.key != key {
+			return n, false
+		}
+
+		return &node[V]{
+			bitmap:  n.bitmap &^ bit,
+			entries: slices.Delete(slices.Clone(n.entries), pos, pos+1),
+		}, true
+	}
+
+	child, removed := del(e.child, shift+bitsPerLevel, h, key)
+	if !removed {
+		return n, false
+	}
+
+	cp := &node[V]{bitmap: n.bitmap, entries: slices.Clone(n.entries)}
+	switch {
+	case len(child.entries) == 0:
+		cp.bitmap &^= bit
+		cp.entries = slices.Delete(cp.entries, pos, pos+1)
+	case len(child.entries) == 1 && child.entries[0].child == nil:
+		// a subtree with a single key can be inlined in its parent
+		cp.entries[pos] = child.entries[0]
+	default:
+		cp.entries[pos] = entry[V]{child: child}
+	}
+
+	return cp, true
+}
+
+// Range calls fn for every element in an unspecified order, stopping early if fn returns false
+func (m *Map[V]) Range(fn func(key string, v V) bool) {
+	walk(m.root, fn)
+}
+
+func walk[V any](n *node[V], fn func(string, V) bool) bool {
+	for _, e := range n.entries {
+		if e.child != nil {
+			if !walk(e.child, fn) {
+				return false
+			}
+			continue
+		}
+
+		if !fn(e.key, e.value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Length returns the amount of elements in the map
+func (m *Map[V]) Length() int {
+	return m.size
+}
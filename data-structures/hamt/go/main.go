@@ -0,0 +1,17 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fr3fou/random-stuff/data-structures/hamt/go/hamt"
+)
+
+func main() {
+	v1 := hamt.New[int]().Set("etc", 1).Set("usr", 2)
+	v2 := v1.Set("home", 3).Delete("etc")
+
+	// v1 is left untouched by the updates which produced v2
+	fmt.Println(v1.Get("etc"))
+	fmt.Println(v2.Get("etc"))
+	fmt.Println(v1.Length(), v2.Length())
+}
@@ -0,0 +1,97 @@
+package heap
+
+// Heap is a binary heap priority queue - the element for which less holds
+// against every other element is always at the top
+type Heap[T any] struct {
+	array []T
+	less  func(a, b T) bool
+}
+
+// New returns an empty heap ordered by less - use a < b for a min heap and a > b for a max heap
+func New[T any](less func(a, b T) bool) *Heap[T] {
+	return &Heap[T]{less: less}
+}
+
+// From builds a heap out of items in O(n)
+func From[T any](items []T, less func(a, b T) bool) *Heap[T] {
+	h := &Heap[T]{array: append([]T(nil), items...), less: less}
+	for i := len(h.array)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+
+	return h
+}
+
+// Push adds an element to the heap - this is O(log n)
+func (h *Heap[T]) Push(v T) {
+	h.array = append(h.array, v)
+	h.up(len(h.array) - 1)
+}
+
+// Pop removes the top element and returns it - this is O(log n)
+func (h *Heap[T]) Pop() T {
+	last := len(h.array) - 1
+	top := h.array[0]
+
+	h.array[0] = h.array[last]
+	var zero T
+	h.array[last] = zero
+	h.array = h.array[:last]
+
+	if last > 0 {
+		h.down(0)
+	}
+
+	return top
+}
+
+// Peek returns the top element without removing it
+func (h *Heap[T]) Peek() T {
+	return h.array[0]
+}
+
+// up moves the element at i towards the root until its parent is in order
+func (h *Heap[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.less(h.array[i], h.array[parent]) {
+			return
+		}
+
+		h.array[i], h.array[parent] = h.array[parent], h.array[i]
+		i = parent
+	}
+}
+
+// down moves the element at i towards the leaves until both children are in order
+func (h *Heap[T]) down(i int) {
+	n := len(h.array)
+	for {
+		top := i
+		left, right := 2*i+1, 2*i+2
+
+		if left < n && h.less(h.array[left], h.array[top]) {
+			top = left
+		}
+		if right < n && h.less(h.array[right], h.array[top]) {
+			top = right
+		}
+
+		if top == i {
+			return
+		}
+
+		h.array[i], h.array[top] = h.array[top], h.array[i]
+		i = top
+	}
+}
+
+// Clear removes all elements from the heap
+func (h *Heap[T]) Clear() {
+	h.array = nil
+}
+
+// Length returns the amount of elements in the heap
+func (h *Heap[T]) Length() int {
+	return len(h.array)
+}
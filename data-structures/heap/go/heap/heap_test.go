@@ -0,0 +1,38 @@
+package heap
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestPopsInOrder(t *testing.T) {
+	items := rand.New(rand.NewSource(1)).Perm(1000)
+
+	pushed := New(func(a, b int) bool { return a < b })
+	for _, v := range items {
+		pushed.Push(v)
+	}
+	built := From(items, func(a, b int) bool { return a < b })
+
+	sort.Ints(items)
+	for _, h := range []*Heap[int]{pushed, built} {
+		for i, want := range items {
+			if got := h.Pop(); got != want {
+				t.Fatalf("pop %d: expected %d, got %d", i, want, got)
+			}
+		}
+
+		if h.Length() != 0 {
+			t.Errorf("expected an empty heap, got length %d", h.Length())
+		}
+	}
+}
+
+func TestMaxHeap(t *testing.T) {
+	h := From([]int{3, 1, 4, 1, 5, 9, 2, 6}, func(a, b int) bool { return a > b })
+
+	if h.Peek() != 9 {
+		t.Errorf("expected 9 on top, got %d", h.Peek())
+	}
+}
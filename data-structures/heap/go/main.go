@@ -0,0 +1,27 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/fr3fou/random-stuff/data-structures/heap/go/heap"
+)
+
+type file struct {
+	name    string
+	expires time.Time
+}
+
+func main() {
+	now := time.Now()
+
+	// a min heap by expiry always has the next file to reap on top
+	h := heap.New(func(a, b file) bool { return a.expires.Before(b.expires) })
+	h.Push(file{"b.txt", now.Add(time.Hour)})
+	h.Push(file{"a.txt", now.Add(time.Minute)})
+	h.Push(file{"c.txt", now.Add(time.Second)})
+
+	for h.Length() > 0 {
+		fmt.Println(h.Pop().name)
+	}
+}
@@ -0,0 +1,123 @@
+package hyperloglog
+
+import (
+	"errors"
+	"hash/fnv"
+	"math"
+	"math/bits"
+)
+
+// ErrPrecisionMismatch is returned when merging sketches with different precisions
+var ErrPrecisionMismatch = errors.New("hyperloglog: precision mismatch")
+
+// Sketch estimates how many distinct elements were added using 2^precision
+// bytes of memory, no matter how many elements there are. The standard error
+// is about 1.04 / sqrt(2^precision)
+type Sketch struct {
+	precision uint8
+	registers []uint8
+}
+
+// New returns an empty sketch - precision is clamped between 4 and 18
+func New(precision uint8) *Sketch {
+	if precision < 4 {
+		precision = 4
+	}
+	if precision > 18 {
+		precision = 18
+	}
+
+	return &Sketch{
+		precision: precision,
+		registers: make([]uint8, 1<<precision),
+	}
+}
+
+// hash returns a well mixed 64 bit hash of data - fnv on its own doesn't
+// spread short similar inputs well enough, so it is finished with splitmix64
+func hash(data []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(data)
+	x := h.Sum64()
+
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+
+	return x
+}
+
+// Add records data - this is O(1)
+func (s *Sketch) Add(data []byte) {
+	x := hash(data)
+
+	// the first bits pick a register, which remembers the longest run of
+	// leading zeros seen in the rest - a run of k zeros takes ~2^k elements
+	i := x >> (64 - s.precision)
+	rank := uint8(bits.LeadingZeros64(x<<s.precision|1<<(s.precision-1))) + 1
+
+	if rank > s.registers[i] {
+		s.registers[i] = rank
+	}
+}
+
+// AddString records str
+func (s *Sketch) AddString(str string) {
+	s.Add([]byte(str))
+}
+
+// Count returns the estimated amount of distinct elements added
+func (s *Sketch) Count() uint64 {
+	m := float64(len(s.registers))
+
+	sum, zeros := 0.0, 0
+	for _, r := range s.registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+
+	alpha := 0.7213 / (1 + 1.079/m)
+	switch len(s.registers) {
+	case 16:
+		alpha = 0.673
+	case 32:
+		alpha = 0.697
+	case 64:
+		alpha = 0.709
+	}
+
+	estimate := alpha * m * m / sum
+
+	// with many empty registers linear counting is more accurate
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+
+	return uint64(estimate + 0.5)
+}
+
+// Merge adds every element of other to s, as if they were added to s directly
+func (s *Sketch) Merge(other *Sketch) error {
+	if s.precision != other.precision {
+		return ErrPrecisionMismatch
+	}
+
+	for i, r := range other.registers {
+		if r > s.registers[i] {
+			s.registers[i] = r
+		}
+	}
+
+	return nil
+}
+
+// Clear resets the sketch
+func (s *Sketch) Clear() {
+	for i := range s.registers {
+		s.registers[i] = 0
+	}
+}
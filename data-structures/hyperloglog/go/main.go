@@ -0,0 +1,18 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fr3fou/random-stuff/data-structures/hyperloglog/go/hyperloglog"
+)
+
+func main() {
+	s := hyperloglog.New(14)
+
+	// a million files, but only 50000 distinct contents
+	for i := 0; i < 1000000; i++ {
+		s.AddString(fmt.Sprintf("content-%d", i%50000))
+	}
+
+	fmt.Println(s.Count())
+}
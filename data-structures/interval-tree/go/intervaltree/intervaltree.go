@@ -0,0 +1,199 @@
+package intervaltree
+
+import "math/rand"
+
+// Interval is the half-open range [Start, End) carrying a value
+type Interval[V any] struct {
+	Start, End int64
+	Value      V
+}
+
+// overlaps reports whether i and [start, end) share at least one point
+func (i Interval[V]) overlaps(start, end int64) bool {
+	return i.Start < end && start < i.End
+}
+
+type node[V any] struct {
+	interval    Interval[V]
+	max         int64 // the largest End in this subtree
+	priority    int
+	left, right *node[V]
+}
+
+func (n *node[V]) update() {
+	n.max = n.interval.End
+	if n.left != nil && n.left.max > n.max {
+		n.max = n.left.max
+	}
+	if n.right != nil && n.right.max > n.max {
+		n.max = n.right.max
+	}
+}
+
+// Tree stores intervals ordered by their start in a treap, where every node
+// also remembers the largest end below it so whole subtrees which end before
+// a query can be skipped
+type Tree[V any] struct {
+	root *node[V]
+	size int
+	rand *rand.Rand
+}
+
+// New returns an empty interval tree
+func New[V any]() *Tree[V] {
+	return &Tree[V]{rand: rand.New(rand.NewSource(1))}
+}
+
+func less(s1, e1, s2, e2 int64) bool {
+	return s1 < s2 || (s1 == s2 && e1 < e2)
+}
+
+func rotateRight[V any](n *node[V]) *node[V] {
+	l := n.left
+	n.left, l.right = l.right, n
+	n.update()
+	l.update()
+	return l
+}
+
+func rotateLeft[V any](n *node[V]) *node[V] {
+	r := n.right
+	n.right, r.left = r.left, n
+	n.update()
+	r.update()
+	return r
+}
+
+// Insert adds [start, end) to the tree - duplicates are allowed
+func (t *Tree[V]) Insert(start, end int64, v V) {
+	t.root = t.insert(t.root, &node[V]{
+		interval: Interval[V]{Start: start, End: end, Value: v},
+		max:      end,
+		priority: t.rand.Int(),
+	})
+	t.size++
+}
+
+func (t *Tree[V]) insert(n, x *node[V]) *node[V] {
+	if n == nil {
+		return x
+	}
+
+	if less(x.interval.Start, x.interval.End, n.interval.Start, n.interval.End) {
+		n.left = t.insert(n.left, x)
+		if n.left.priority > n.priority {
+			n = rotateRight(n)
+		}
+	} else {
+		n.right = t.insert(n.right, x)
+		if n.right.priority > n.priority {
+			n = rotateLeft(n)
+		}
+	}
+
+	n.update()
+	return n
+}
+
+// Delete removes one interval with exactly the bounds [start, end)
+func (t *Tree[V]) Delete(start, end int64) bool {
+	var removed bool
+	t.root = t.delete(t.root, start, end, &removed)
+	if removed {
+		t.size--
+	}
+
+	return removed
+}
+
+func (t *Tree[V]) delete(n *node[V], start, end int64, removed *bool) *node[V] {
+	if n == nil {
+		return nil
+	}
+
+	switch {
+	case less(start, end, n.interval.Start, n.interval.End):
+		n.left = t.delete(n.left, start, end, removed)
+	case less(n.interval.Start, n.interval.End, start, end):
+		n.right = t.delete(n.right, start, end, removed)
+	default:
+		*removed = true
+		return t.remove(n)
+	}
+
+	n.update()
+	return n
+}
+
+// remove rotates n down until it has at most one child and then splices it out
+func (t *Tree[V]) remove(n *node[V]) *node[V] {
+	switch {
+	case n.left == nil:
+		return n.right
+	case n.right == nil:
+		return n.left
+	case n.left.priority > n.right.priority:
+		n = rotateRight(n)
+		n.right = t.remove(n.right)
+	default:
+		n = rotateLeft(n)
+		n.left = t.remove(n.left)
+	}
+
+	n.update()
+	return n
+}
+
+// Overlapping returns every interval sharing at least one point with [start, end),
+// ordered by start - this is O(log n + k) where k is the amount of matches
+func (t *Tree[V]) Overlapping(start, end int64) []Interval[V] {
+	var out []Interval[V]
+	visit(t.root, start, end, func(i Interval[V]) bool {
+		out = append(out, i)
+		return true
+	})
+
+	return out
+}
+
+// AnyOverlapping returns the first interval sharing at least one point with [start, end)
+func (t *Tree[V]) AnyOverlapping(start, end int64) (Interval[V], bool) {
+	var (
+		found Interval[V]
+		ok    bool
+	)
+
+	visit(t.root, start, end, func(i Interval[V]) bool {
+		found, ok = i, true
+		return false
+	})
+
+	return found, ok
+}
+
+func visit[V any](n *node[V], start, end int64, fn func(Interval[V]) bool) bool {
+	// nothing in this subtree reaches start
+	if n == nil || n.max <= start {
+		return true
+	}
+
+	if !visit(n.left, start, end, fn) {
+		return false
+	}
+
+	if n.interval.overlaps(start, end) && !fn(n.interval) {
+		return false
+	}
+
+	// everything on the right starts at or after this node
+	if n.interval.Start >= end {
+		return true
+	}
+
+	return visit(n.right, start, end, fn)
+}
+
+// Length returns the amount of intervals in the tree
+func (t *Tree[V]) Length() int {
+	return t.size
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fr3fou/random-stuff/data-structures/interval-tree/go/intervaltree"
+)
+
+func main() {
+	locks := intervaltree.New[string]()
+	locks.Insert(0, 100, "alice")
+	locks.Insert(200, 300, "bob")
+	locks.Insert(250, 400, "carol")
+
+	fmt.Println(locks.Overlapping(50, 260))
+	fmt.Println(locks.AnyOverlapping(100, 200))
+
+	locks.Delete(200, 300)
+	fmt.Println(locks.Overlapping(0, 1000))
+	fmt.Println(locks.Length())
+}
@@ -0,0 +1,106 @@
+package lru
+
+import (
+	"container/list"
+	"time"
+)
+
+type entry[K comparable, V any] struct {
+	key     K
+	value   V
+	expires time.Time
+}
+
+// Cache is a fixed capacity cache which evicts the least recently used element
+type Cache[K comparable, V any] struct {
+	capacity int
+	ttl      time.Duration
+	items    map[K]*list.Element
+	order    *list.List // front is the most recently used
+	now      func() time.Time
+}
+
+// New returns a cache holding at most capacity elements
+func New[K comparable, V any](capacity int) *Cache[K, V] {
+	return NewWithTTL[K, V](capacity, 0)
+}
+
+// NewWithTTL returns a cache whose elements also expire ttl after being put -
+// a ttl of 0 means elements never expire
+func NewWithTTL[K comparable, V any](capacity int, ttl time.Duration) *Cache[K, V] {
+	return &Cache[K, V]{
+		capacity: capacity,
+		ttl:      ttl,
+		items:    map[K]*list.Element{},
+		order:    list.New(),
+		now:      time.Now,
+	}
+}
+
+// Put inserts or updates key and marks it as the most recently used - this is O(1)
+func (c *Cache[K, V]) Put(key K, v V) {
+	var expires time.Time
+	if c.ttl > 0 {
+		expires = c.now().Add(c.ttl)
+	}
+
+	if el, ok := c.items[key]; ok {
+		e := el.Value.(*entry[K, V])
+		e.value, e.expires = v, expires
+		c.order.MoveToFront(el)
+		return
+	}
+
+	c.items[key] = c.order.PushFront(&entry[K, V]{key: key, value: v, expires: expires})
+
+	if c.order.Len() > c.capacity {
+		c.remove(c.order.Back())
+	}
+}
+
+// Get returns the value for key and marks it as the most recently used - this is O(1)
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	el, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	e := el.Value.(*entry[K, V])
+	if !e.expires.IsZero() && !c.now().Before(e.expires) {
+		c.remove(el)
+		var zero V
+		return zero, false
+	}
+
+	c.order.MoveToFront(el)
+	return e.value, true
+}
+
+// Delete removes key from the cache
+func (c *Cache[K, V]) Delete(key K) bool {
+	el, ok := c.items[key]
+	if !ok {
+		return false
+	}
+
+	c.remove(el)
+	return true
+}
+
+func (c *Cache[K, V]) remove(el *list.Element) {
+	e := c.order.Remove(el).(*entry[K, V])
+	delete(c.items, e.key)
+}
+
+// Clear removes all elements from the cache
+func (c *Cache[K, V]) Clear() {
+	c.items = map[K]*list.Element{}
+	c.order.Init()
+}
+
+// Length returns the amount of elements in the cache, including expired ones
+// which haven't been looked up yet
+func (c *Cache[K, V]) Length() int {
+	return c.order.Len()
+}
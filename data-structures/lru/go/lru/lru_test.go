@@ -0,0 +1,71 @@
+package lru
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestEvictsLeastRecentlyUsed(t *testing.T) {
+	c := New[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Put("c", 3)
+
+	if _, ok := c.Get("b"); ok {
+		t.Error("expected b to be evicted")
+	}
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Errorf("expected a=1, got %v %v", v, ok)
+	}
+	if c.Length() != 2 {
+		t.Errorf("expected length 2, got %d", c.Length())
+	}
+}
+
+func TestExpires(t *testing.T) {
+	now := time.Unix(0, 0)
+	c := NewWithTTL[string, int](2, time.Second)
+	c.now = func() time.Time { return now }
+
+	c.Put("a", 1)
+	if _, ok := c.Get("a"); !ok {
+		t.Error("expected a to be present")
+	}
+
+	now = now.Add(time.Second)
+	if _, ok := c.Get("a"); ok {
+		t.Error("expected a to be expired")
+	}
+	if c.Length() != 0 {
+		t.Errorf("expected length 0, got %d", c.Length())
+	}
+}
+
+func BenchmarkPut(b *testing.B) {
+	c := New[string, int](1024)
+	keys := make([]string, 4096)
+	for i := range keys {
+		keys[i] = strconv.Itoa(i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c.Put(keys[i%len(keys)], i)
+	}
+}
+
+func BenchmarkGet(b *testing.B) {
+	c := New[string, int](1024)
+	keys := make([]string, 1024)
+	for i := range keys {
+		keys[i] = strconv.Itoa(i)
+		c.Put(keys[i], i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c.Get(keys[i%len(keys)])
+	}
+}
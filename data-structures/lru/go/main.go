@@ -0,0 +1,19 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fr3fou/random-stuff/data-structures/lru/go/lru"
+)
+
+func main() {
+	c := lru.New[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Put("c", 3) // evicts b
+
+	fmt.Println(c.Get("a"))
+	fmt.Println(c.Get("b"))
+	fmt.Println(c.Length())
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fr3fou/random-stuff/data-structures/ring-buffer/go/ring"
+)
+
+func main() {
+	for _, policy := range []ring.Policy{ring.OverwriteOldest, ring.DropNewest} {
+		b := ring.New[string](3, policy)
+		for _, ev := range []string{"create", "write", "chmod", "rename", "remove"} {
+			b.Push(ev)
+		}
+
+		for b.Length() > 0 {
+			ev, _ := b.Pop()
+			fmt.Print(ev, " ")
+		}
+		fmt.Println("dropped:", b.Dropped())
+	}
+}
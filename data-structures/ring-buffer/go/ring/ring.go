@@ -0,0 +1,101 @@
+package ring
+
+// Policy decides what happens when pushing into a full buffer
+type Policy int
+
+const (
+	// OverwriteOldest drops the oldest element to make room for the new one
+	OverwriteOldest Policy = iota
+	// DropNewest keeps the buffer as is and discards the new element
+	DropNewest
+)
+
+// Buffer is a fixed capacity FIFO queue backed by a circular array
+type Buffer[T any] struct {
+	array   []T
+	head    int // index of the oldest element
+	length  int
+	policy  Policy
+	dropped uint64
+}
+
+// New returns an empty buffer holding at most capacity elements
+func New[T any](capacity int, policy Policy) *Buffer[T] {
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	return &Buffer[T]{
+		array:  make([]T, capacity),
+		policy: policy,
+	}
+}
+
+// Push adds an element to the end of the buffer, returning false if an
+// element (either the oldest or v itself) had to be dropped - this is O(1)
+func (b *Buffer[T]) Push(v T) bool {
+	if b.length < len(b.array) {
+		b.array[(b.head+b.length)%len(b.array)] = v
+		b.length++
+		return true
+	}
+
+	b.dropped++
+	if b.policy == DropNewest {
+		return false
+	}
+
+	b.array[b.head] = v
+	b.head = (b.head + 1) % len(b.array)
+	return false
+}
+
+// Pop removes the oldest element and returns it
+func (b *Buffer[T]) Pop() (T, bool) {
+	var zero T
+	if b.length == 0 {
+		return zero, false
+	}
+
+	v := b.array[b.head]
+	b.array[b.head] = zero
+	b.head = (b.head + 1) % len(b.array)
+	b.length--
+
+	return v, true
+}
+
+// Peek returns the oldest element without removing it
+func (b *Buffer[T]) Peek() (T, bool) {
+	if b.length == 0 {
+		var zero T
+		return zero, false
+	}
+
+	return b.array[b.head], true
+}
+
+// Dropped returns how many elements were discarded because the buffer was full
+func (b *Buffer[T]) Dropped() uint64 {
+	return b.dropped
+}
+
+// Clear removes all elements from the buffer, keeping the dropped counter
+func (b *Buffer[T]) Clear() {
+	var zero T
+	for i := range b.array {
+		b.array[i] = zero
+	}
+
+	b.head, b.length = 0, 0
+}
+
+// Length returns the amount of elements in the buffer
+func (b *Buffer[T]) Length() int {
+	return b.length
+}
+
+// Capacity returns the maximum amount of elements the buffer can hold
+func (b *Buffer[T]) Capacity() int {
+	return len(b.array)
+}
@@ -0,0 +1,7 @@
+module github.com/fr3fou/random-stuff/data-structures/skip-list/go
+
+go 1.21
+
+require github.com/fr3fou/random-stuff/data-structures/btree/go v0.0.0
+
+replace github.com/fr3fou/random-stuff/data-structures/btree/go => ../../btree/go
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fr3fou/random-stuff/data-structures/skip-list/go/skiplist"
+)
+
+func main() {
+	s := skiplist.New[string, int]()
+	for i, name := range []string{"usr", "etc", "home", "var", "bin"} {
+		s.Put(name, i)
+	}
+
+	s.Delete("home")
+	s.AscendFrom("c", func(k string, v int) bool {
+		fmt.Println(k, v)
+		return true
+	})
+
+	fmt.Println(s.Get("bin"))
+	fmt.Println(s.Length())
+}
@@ -0,0 +1,143 @@
+package skiplist
+
+import (
+	"cmp"
+	"math/rand"
+)
+
+const maxLevel = 32
+
+type node[K cmp.Ordered, V any] struct {
+	key   K
+	value V
+	next  []*node[K, V]
+}
+
+// SkipList is an ordered map built from a sorted linked list with extra
+// "express lanes" on top, giving O(log n) operations on average
+type SkipList[K cmp.Ordered, V any] struct {
+	head  *node[K, V]
+	level int
+	size  int
+	rand  *rand.Rand
+}
+
+// New returns an empty skip list
+func New[K cmp.Ordered, V any]() *SkipList[K, V] {
+	return NewWithSeed[K, V](1)
+}
+
+// NewWithSeed returns an empty skip list whose node heights are drawn from the given seed
+func NewWithSeed[K cmp.Ordered, V any](seed int64) *SkipList[K, V] {
+	return &SkipList[K, V]{
+		head:  &node[K, V]{next: make([]*node[K, V], maxLevel)},
+		level: 1,
+		rand:  rand.New(rand.NewSource(seed)),
+	}
+}
+
+// randomLevel flips coins until it gets tails, so every level has half the nodes of the one below
+func (s *SkipList[K, V]) randomLevel() int {
+	level := 1
+	for level < maxLevel && s.rand.Intn(2) == 0 {
+		level++
+	}
+
+	return level
+}
+
+// predecessors returns the last node before k on every level
+func (s *SkipList[K, V]) predecessors(k K) [maxLevel]*node[K, V] {
+	var update [maxLevel]*node[K, V]
+
+	n := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for n.next[i] != nil && n.next[i].key < k {
+			n = n.next[i]
+		}
+		update[i] = n
+	}
+
+	return update
+}
+
+// Get returns the value stored for k
+func (s *SkipList[K, V]) Get(k K) (V, bool) {
+	n := s.predecessors(k)[0].next[0]
+	if n != nil && n.key == k {
+		return n.value, true
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Put sets the value for k, replacing any previous one
+func (s *SkipList[K, V]) Put(k K, v V) {
+	update := s.predecessors(k)
+
+	if n := update[0].next[0]; n != nil && n.key == k {
+		n.value = v
+		return
+	}
+
+	level := s.randomLevel()
+	for i := s.level; i < level; i++ {
+		update[i] = s.head
+	}
+	if level > s.level {
+		s.level = level
+	}
+
+	n := &node[K, V]{key: k, value: v, next: make([]*node[K, V], level)}
+	for i := 0; i < level; i++ {
+		n.next[i] = update[i].next[i]
+		update[i].next[i] = n
+	}
+
+	s.size++
+}
+
+// Delete removes k from the skip list
+func (s *SkipList[K, V]) Delete(k K) bool {
+	update := s.predecessors(k)
+
+	n := update[0].next[0]
+	if n == nil || n.key != k {
+		return false
+	}
+
+	for i := range n.next {
+		update[i].next[i] = n.next[i]
+	}
+
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+
+	s.size--
+	return true
+}
+
+// Ascend calls fn for every element in ascending key order, stopping early if fn returns false
+func (s *SkipList[K, V]) Ascend(fn func(k K, v V) bool) {
+	for n := s.head.next[0]; n != nil; n = n.next[0] {
+		if !fn(n.key, n.value) {
+			return
+		}
+	}
+}
+
+// AscendFrom calls fn for every element with a key >= from in ascending order
+func (s *SkipList[K, V]) AscendFrom(from K, fn func(k K, v V) bool) {
+	for n := s.predecessors(from)[0].next[0]; n != nil; n = n.next[0] {
+		if !fn(n.key, n.value) {
+			return
+		}
+	}
+}
+
+// Length returns the amount of elements in the skip list
+func (s *SkipList[K, V]) Length() int {
+	return s.size
+}
@@ -0,0 +1,89 @@
+package skiplist
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/fr3fou/random-stuff/data-structures/btree/go/btree"
+)
+
+var keys = rand.New(rand.NewSource(42)).Perm(10000)
+
+func TestAgainstMap(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	s := New[int, int]()
+	m := map[int]int{}
+
+	for i := 0; i < 20000; i++ {
+		k := r.Intn(1000)
+		if r.Intn(3) == 0 {
+			_, ok := m[k]
+			if s.Delete(k) != ok {
+				t.Fatalf("delete %d: expected %v", k, ok)
+			}
+			delete(m, k)
+		} else {
+			s.Put(k, i)
+			m[k] = i
+		}
+
+		if s.Length() != len(m) {
+			t.Fatalf("expected length %d, got %d", len(m), s.Length())
+		}
+	}
+
+	for k, want := range m {
+		if got, ok := s.Get(k); !ok || got != want {
+			t.Errorf("get %d: expected %d, got %d %v", k, want, got, ok)
+		}
+	}
+
+	sorted := []int{}
+	for k := range m {
+		if k >= 500 {
+			sorted = append(sorted, k)
+		}
+	}
+	slices.Sort(sorted)
+
+	got := []int{}
+	s.AscendFrom(500, func(k, v int) bool {
+		got = append(got, k)
+		return true
+	})
+
+	if !slices.Equal(got, sorted) {
+		t.Errorf("AscendFrom(500): expected %v, got %v", sorted, got)
+	}
+}
+
+func BenchmarkSkipList(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		s := New[int, int]()
+		for _, k := range keys {
+			s.Put(k, k)
+		}
+	}
+}
+
+func BenchmarkBTree(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		t := btree.New[int, int](32)
+		for _, k := range keys {
+			t.Put(k, k)
+		}
+	}
+}
+
+func BenchmarkSortedSlice(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		s := []int{}
+		for _, k := range keys {
+			j, found := slices.BinarySearch(s, k)
+			if !found {
+				s = slices.Insert(s, j, k)
+			}
+		}
+	}
+}
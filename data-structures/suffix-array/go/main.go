@@ -0,0 +1,15 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fr3fou/random-stuff/data-structures/suffix-array/go/suffixarray"
+)
+
+func main() {
+	x := suffixarray.New([]byte("banana bandana"))
+
+	fmt.Println(x.Lookup([]byte("an")))
+	fmt.Println(x.Count([]byte("ana")))
+	fmt.Printf("%q\n", x.LongestRepeated())
+}
@@ -0,0 +1,150 @@
+package suffixarray
+
+import (
+	"bytes"
+	"slices"
+	"sort"
+)
+
+// Index is the sorted list of all suffixes of a text together with the
+// longest common prefix of every pair of neighbouring suffixes. Once built,
+// finding every occurrence of a pattern is a binary search
+type Index struct {
+	data []byte
+	sa   []int // sa[i] is the start of the i-th smallest suffix
+	lcp  []int // lcp[i] is the common prefix length of suffixes sa[i-1] and sa[i]
+}
+
+// New builds the index of data using prefix doubling - this is O(n log^2 n)
+func New(data []byte) *Index {
+	n := len(data)
+	sa := make([]int, n)
+	rank := make([]int, n)
+	tmp := make([]int, n)
+
+	for i := range sa {
+		sa[i] = i
+		rank[i] = int(data[i])
+	}
+
+	// after the round for k, suffixes are sorted by their first 2k bytes
+	for k := 1; ; k *= 2 {
+		// rank of the second half, -1 meaning "past the end" which sorts first
+		second := func(i int) int {
+			if i+k < n {
+				return rank[i+k]
+			}
+			return -1
+		}
+
+		sort.Slice(sa, func(a, b int) bool {
+			i, j := sa[a], sa[b]
+			if rank[i] != rank[j] {
+				return rank[i] < rank[j]
+			}
+			return second(i) < second(j)
+		})
+
+		if n == 0 {
+			break
+		}
+
+		tmp[sa[0]] = 0
+		for i := 1; i < n; i++ {
+			prev, cur := sa[i-1], sa[i]
+			tmp[cur] = tmp[prev]
+			if rank[prev] != rank[cur] || second(prev) != second(cur) {
+				tmp[cur]++
+			}
+		}
+		copy(rank, tmp)
+
+		// every suffix has a distinct rank, so they are fully sorted
+		if rank[sa[n-1]] == n-1 {
+			break
+		}
+	}
+
+	return &Index{data: data, sa: sa, lcp: kasai(data, sa, rank)}
+}
+
+// kasai computes the LCP array in O(n) using the fact that removing the first
+// byte of a suffix shortens its common prefix with its neighbour by at most 1
+func kasai(data []byte, sa, rank []int) []int {
+	n := len(data)
+	lcp := make([]int, n)
+
+	h := 0
+	for i := 0; i < n; i++ {
+		if rank[i] == 0 {
+			h = 0
+			continue
+		}
+
+		j := sa[rank[i]-1]
+		for i+h < n && j+h < n && data[i+h] == data[j+h] {
+			h++
+		}
+		lcp[rank[i]] = h
+
+		if h > 0 {
+			h--
+		}
+	}
+
+	return lcp
+}
+
+// bounds returns the range of sa whose suffixes start with pattern
+func (x *Index) bounds(pattern []byte) (int, int) {
+	prefix := func(i int) []byte {
+		s := x.data[x.sa[i]:]
+		if len(s) > len(pattern) {
+			s = s[:len(pattern)]
+		}
+		return s
+	}
+
+	lo := sort.Search(len(x.sa), func(i int) bool {
+		return bytes.Compare(prefix(i), pattern) >= 0
+	})
+	hi := sort.Search(len(x.sa), func(i int) bool {
+		return bytes.Compare(prefix(i), pattern) > 0
+	})
+
+	return lo, hi
+}
+
+// Lookup returns the sorted start indices of all occurrences of pattern -
+// this is O(m log n + k)
+func (x *Index) Lookup(pattern []byte) []int {
+	lo, hi := x.bounds(pattern)
+	indices := slices.Clone(x.sa[lo:hi])
+	slices.Sort(indices)
+
+	return indices
+}
+
+// Count returns the amount of occurrences of pattern - this is O(m log n)
+func (x *Index) Count(pattern []byte) int {
+	lo, hi := x.bounds(pattern)
+	return hi - lo
+}
+
+// LongestRepeated returns the longest substring occurring at least twice -
+// it is the longest common prefix of some two neighbouring suffixes
+func (x *Index) LongestRepeated() []byte {
+	best := 0
+	for i := 1; i < len(x.lcp); i++ {
+		if x.lcp[i] > x.lcp[best] {
+			best = i
+		}
+	}
+
+	if len(x.lcp) == 0 || x.lcp[best] == 0 {
+		return nil
+	}
+
+	start := x.sa[best]
+	return x.data[start : start+x.lcp[best]]
+}
@@ -0,0 +1,3 @@
+module github.com/fr3fou/random-stuff/data-structures/trie/go
+
+go 1.18
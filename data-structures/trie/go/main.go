@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fr3fou/random-stuff/data-structures/trie/go/trie"
+)
+
+func main() {
+	t := trie.New[int]()
+	t.Insert("/usr", 1)
+	t.Insert("/usr/local", 2)
+	t.Insert("/usr/local/bin", 3)
+	t.Insert("/home", 4)
+
+	fmt.Println(t.LongestPrefix("/usr/local/share/man"))
+
+	t.Delete("/usr/local")
+	t.Walk("/usr", func(key string, v int) bool {
+		fmt.Println(key, v)
+		return true
+	})
+	fmt.Println(t.Length())
+}
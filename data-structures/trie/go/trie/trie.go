@@ -0,0 +1,157 @@
+package trie
+
+import "sort"
+
+type node[V any] struct {
+	children map[byte]*node[V]
+	value    V
+	terminal bool
+}
+
+// Trie is a prefix tree mapping string keys to values
+type Trie[V any] struct {
+	root *node[V]
+	size int
+}
+
+// New returns an empty trie
+func New[V any]() *Trie[V] {
+	return &Trie[V]{root: &node[V]{}}
+}
+
+// Insert sets the value for key, replacing any previous one - this is O(len(key))
+func (t *Trie[V]) Insert(key string, v V) {
+	n := t.root
+	for i := 0; i < len(key); i++ {
+		if n.children == nil {
+			n.children = map[byte]*node[V]{}
+		}
+
+		next, ok := n.children[key[i]]
+		if !ok {
+			next = &node[V]{}
+			n.children[key[i]] = next
+		}
+		n = next
+	}
+
+	if !n.terminal {
+		t.size++
+	}
+	n.value = v
+	n.terminal = true
+}
+
+// Get returns the value stored for key
+func (t *Trie[V]) Get(key string) (V, bool) {
+	n := t.root
+	for i := 0; i < len(key) && n != nil; i++ {
+		n = n.children[key[i]]
+	}
+
+	if n == nil || !n.terminal {
+		var zero V
+		return zero, false
+	}
+
+	return n.value, true
+}
+
+// Delete removes key from the trie and prunes the nodes left without children
+func (t *Trie[V]) Delete(key string) bool {
+	// remember the path so we can prune it bottom up
+	path := make([]*node[V], 0, len(key)+1)
+	n := t.root
+	for i := 0; i < len(key) && n != nil; i++ {
+		path = append(path, n)
+		n = n.children[key[i]]
+	}
+
+	if n == nil || !n.terminal {
+		return false
+	}
+
+	var zero V
+	n.value = zero
+	n.terminal = false
+	t.size--
+
+	for i := len(key) - 1; i >= 0; i-- {
+		if n.terminal || len(n.children) > 0 {
+			break
+		}
+
+		parent := path[i]
+		delete(parent.children, key[i])
+		n = parent
+	}
+
+	return true
+}
+
+// LongestPrefix returns the longest key in the trie which is a prefix of s
+func (t *Trie[V]) LongestPrefix(s string) (string, V, bool) {
+	var (
+		key   string
+		value V
+		found bool
+	)
+
+	n := t.root
+	for i := 0; ; i++ {
+		if n.terminal {
+			key, value, found = s[:i], n.value, true
+		}
+
+		if i == len(s) {
+			break
+		}
+
+		n = n.children[s[i]]
+		if n == nil {
+			break
+		}
+	}
+
+	return key, value, found
+}
+
+// Walk calls fn for every key starting with prefix in lexicographical order,
+// stopping early if fn returns false
+func (t *Trie[V]) Walk(prefix string, fn func(key string, v V) bool) {
+	n := t.root
+	for i := 0; i < len(prefix) && n != nil; i++ {
+		n = n.children[prefix[i]]
+	}
+
+	if n == nil {
+		return
+	}
+
+	walk(n, []byte(prefix), fn)
+}
+
+func walk[V any](n *node[V], key []byte, fn func(string, V) bool) bool {
+	if n.terminal && !fn(string(key), n.value) {
+		return false
+	}
+
+	edges := make([]byte, 0, len(n.children))
+	for b := range n.children {
+		edges = append(edges, b)
+	}
+	sort.Slice(edges, func(i, j int) bool { return edges[i] < edges[j] })
+
+	for _, b := range edges {
+		if !walk(n.children[b], append(key, b), fn) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Length returns the amount of keys in the trie
+func (t *Trie[V]) Length() int {
+	return t.size
+}
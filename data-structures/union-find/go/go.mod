@@ -0,0 +1,3 @@
+module github.com/fr3fou/random-stuff/data-structures/union-find/go
+
+go 1.21
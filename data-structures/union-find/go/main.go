@@ -0,0 +1,37 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/fr3fou/random-stuff/data-structures/union-find/go/unionfind"
+)
+
+func main() {
+	files := []string{"a.txt", "b.txt", "c.txt", "d.txt", "e.txt"}
+	contents := []string{"hello", "world", "hello", "foo", "world"}
+
+	// union every file with the first one that had the same content
+	u := unionfind.New(len(files))
+	seen := map[string]int{}
+	for i, c := range contents {
+		if j, ok := seen[c]; ok {
+			u.Union(i, j)
+			continue
+		}
+		seen[c] = i
+	}
+
+	for _, group := range u.Groups() {
+		if len(group) < 2 {
+			continue
+		}
+
+		names := []string{}
+		for _, i := range group {
+			names = append(names, files[i])
+		}
+		fmt.Println(names)
+	}
+
+	fmt.Println(u.Sets())
+}
@@ -0,0 +1,94 @@
+package unionfind
+
+// UnionFind keeps track of elements 0..n-1 split into disjoint sets
+type UnionFind struct {
+	parent []int
+	size   []int
+	sets   int
+}
+
+// New returns n elements, each in its own set
+func New(n int) *UnionFind {
+	u := &UnionFind{
+		parent: make([]int, n),
+		size:   make([]int, n),
+		sets:   n,
+	}
+
+	for i := range u.parent {
+		u.parent[i] = i
+		u.size[i] = 1
+	}
+
+	return u
+}
+
+// Find returns the representative of the set x is in - with path
+// compression and union by size this is practically O(1)
+func (u *UnionFind) Find(x int) int {
+	root := x
+	for u.parent[root] != root {
+		root = u.parent[root]
+	}
+
+	// point everything on the way directly at the root
+	for u.parent[x] != root {
+		u.parent[x], x = root, u.parent[x]
+	}
+
+	return root
+}
+
+// Union merges the sets of x and y, returning false if they were already the same set
+func (u *UnionFind) Union(x, y int) bool {
+	x, y = u.Find(x), u.Find(y)
+	if x == y {
+		return false
+	}
+
+	// hang the smaller tree under the bigger one
+	if u.size[x] < u.size[y] {
+		x, y = y, x
+	}
+
+	u.parent[y] = x
+	u.size[x] += u.size[y]
+	u.sets--
+
+	return true
+}
+
+// Connected checks if x and y are in the same set
+func (u *UnionFind) Connected(x, y int) bool {
+	return u.Find(x) == u.Find(y)
+}
+
+// Size returns the amount of elements in the set x is in
+func (u *UnionFind) Size(x int) int {
+	return u.size[u.Find(x)]
+}
+
+// Sets returns the amount of disjoint sets
+func (u *UnionFind) Sets() int {
+	return u.sets
+}
+
+// Groups returns the elements of every set, ordered by their smallest element
+func (u *UnionFind) Groups() [][]int {
+	index := map[int]int{}
+	groups := [][]int{}
+
+	for x := range u.parent {
+		root := u.Find(x)
+		i, ok := index[root]
+		if !ok {
+			i = len(groups)
+			index[root] = i
+			groups = append(groups, nil)
+		}
+
+		groups[i] = append(groups[i], x)
+	}
+
+	return groups
+}